@@ -0,0 +1,192 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+// fastDNSZoneTypeFeatures maps the contract features that permit a zone
+// type, as returned in the features of the contracts API, to that type.
+// The API has no field listing zone types, so contracts whose features
+// don't use exactly these names report no zone types even when they do
+// permit some. Check features directly in that case.
+var fastDNSZoneTypeFeatures = []struct {
+	Feature, ZoneType string
+}{
+	{"PRIMARY_ZONE", "PRIMARY"},
+	{"SECONDARY_ZONE", "SECONDARY"},
+	{"ALIAS_ZONE", "ALIAS"},
+}
+
+func dataSourceAkamaiContract() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAkamaiContractRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"contract_id"},
+			},
+
+			"type_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"features": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			// Derived from features, see fastDNSZoneTypeFeatures.
+			"zone_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"zone_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"maximum_zones": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"contract_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAkamaiContractRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AkamaiClient).client
+
+	log.Printf("[DEBUG] Listing Akamai contracts")
+	output, _, err := listContracts(context.Background(), conn)
+	if err != nil {
+		return fmt.Errorf("error listing Akamai contracts: %s", err)
+	}
+
+	var contracts []*akamai.Contract
+	if output != nil {
+		contracts = output.Contracts
+	}
+
+	ids := make([]string, 0, len(contracts))
+	for _, c := range contracts {
+		if c != nil && c.ContractID != nil {
+			ids = append(ids, *c.ContractID)
+		}
+	}
+	if err := d.Set("contract_ids", ids); err != nil {
+		return fmt.Errorf("error setting contract_ids: %s", err)
+	}
+
+	id, idOk := d.GetOk("contract_id")
+	name, nameOk := d.GetOk("name")
+
+	// Without a contract_id or name, only list the available contracts.
+	if !idOk && !nameOk {
+		d.SetId(strings.Join(ids, ","))
+		return nil
+	}
+
+	var match *akamai.Contract
+	for _, c := range contracts {
+		if c == nil || c.ContractID == nil {
+			continue
+		}
+		if idOk && *c.ContractID != id.(string) {
+			continue
+		}
+		if nameOk && (c.ContractName == nil || *c.ContractName != name.(string)) {
+			continue
+		}
+
+		if match != nil {
+			return fmt.Errorf("more than one Akamai contract matched, use contract_id to pick one")
+		}
+		match = c
+	}
+
+	if match == nil {
+		return fmt.Errorf("no matching Akamai contract found")
+	}
+
+	d.SetId(*match.ContractID)
+	d.Set("contract_id", match.ContractID)
+	d.Set("name", match.ContractName)
+	d.Set("type_name", match.ContractTypeName)
+	d.Set("zone_count", match.ZoneCount)
+	d.Set("maximum_zones", match.MaximumZones)
+	if err := d.Set("features", flattenStringPointers(match.Features)); err != nil {
+		return fmt.Errorf("error setting features: %s", err)
+	}
+	if err := d.Set("permissions", flattenStringPointers(match.Permissions)); err != nil {
+		return fmt.Errorf("error setting permissions: %s", err)
+	}
+	if err := d.Set("zone_types", contractZoneTypes(match.Features)); err != nil {
+		return fmt.Errorf("error setting zone_types: %s", err)
+	}
+
+	return nil
+}
+
+// contractZoneTypes returns the zone types permitted by a contract's
+// features, in the order of fastDNSZoneTypeFeatures.
+func contractZoneTypes(features []*string) []string {
+	types := make([]string, 0, len(fastDNSZoneTypeFeatures))
+	for _, m := range fastDNSZoneTypeFeatures {
+		for _, f := range features {
+			if f != nil && *f == m.Feature {
+				types = append(types, m.ZoneType)
+				break
+			}
+		}
+	}
+	return types
+}
+
+func flattenStringPointers(l []*string) []string {
+	strs := make([]string, 0, len(l))
+	for _, s := range l {
+		if s != nil {
+			strs = append(strs, *s)
+		}
+	}
+	return strs
+}
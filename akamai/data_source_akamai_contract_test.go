@@ -0,0 +1,44 @@
+package akamai
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestContractZoneTypes(t *testing.T) {
+	primary, alias, dnssec, secondary := "PRIMARY_ZONE", "ALIAS_ZONE", "DNSSEC", "SECONDARY_ZONES"
+	features := []*string{&alias, &dnssec, &secondary, nil, &primary}
+
+	// SECONDARY_ZONES is not an exact match, so it doesn't count.
+	actual := contractZoneTypes(features)
+	expected := []string{"PRIMARY", "ALIAS"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("output: %v, expected %v", actual, expected)
+	}
+}
+
+func TestAccDataSourceAkamaiContract(t *testing.T) {
+	dsName := "data.akamai_contract.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAkamaiContractConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "contract_id", "G-2LP9RJ3"),
+					resource.TestCheckResourceAttrSet(dsName, "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAkamaiContractConfig = `
+data "akamai_contract" "test" {
+  contract_id = "G-2LP9RJ3"
+}
+`
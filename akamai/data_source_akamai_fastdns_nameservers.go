@@ -0,0 +1,84 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAkamaiFastDNSNameServers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAkamaiFastDNSNameServersRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"contract_id"},
+			},
+
+			"contract_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"zone"},
+			},
+
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAkamaiFastDNSNameServersRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AkamaiClient).client
+
+	var ns []string
+	if v, ok := d.GetOk("zone"); ok {
+		zone := v.(string)
+
+		log.Printf("[DEBUG] Getting Akamai FastDNS Hosted Zone: %s", zone)
+		output, resp, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+		if resp != nil && resp.StatusCode == 404 {
+			return fmt.Errorf("Akamai FastDNS Zone (%s) not found", zone)
+		}
+		if err != nil {
+			return fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", zone, err)
+		}
+		if output == nil || output.Zone == nil {
+			return fmt.Errorf("Akamai FastDNS Zone (%s) not found", zone)
+		}
+
+		ns, err = getFastDNSZoneDelegationSet(conn, output)
+		if err != nil {
+			return err
+		}
+		d.SetId(zone)
+	} else if v, ok := d.GetOk("contract_id"); ok {
+		cid := v.(string)
+
+		var err error
+		ns, err = getFastDNSContractNameServers(conn, cid)
+		if err != nil {
+			return err
+		}
+		d.SetId(cid)
+	} else {
+		return fmt.Errorf("one of zone or contract_id must be set")
+	}
+
+	if len(ns) == 0 {
+		return fmt.Errorf("no authoritative name servers found")
+	}
+
+	if err := d.Set("name_servers", ns); err != nil {
+		return fmt.Errorf("error setting name_servers: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package akamai
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAkamaiFastDNSNameServers(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-ns-%s.terraformtest.com", acctest.RandString(8))
+	dsName := "data.akamai_fastdns_nameservers.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAkamaiFastDNSNameServersConfig(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dsName, "name_servers.0", regexp.MustCompile(`\.akam\.net$`)),
+					resource.TestCheckResourceAttrPair(dsName, "name_servers.#", "akamai_fastdns_zone.test", "name_servers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAkamaiFastDNSNameServersConfig(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+data "akamai_fastdns_nameservers" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+}
+`, zone)
+}
@@ -0,0 +1,90 @@
+package akamai
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAkamaiFastDNSRecord() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAkamaiFastDNSRecordRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(fastDNSRecordTypes, false),
+			},
+
+			"rdata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAkamaiFastDNSRecordRead(d *schema.ResourceData, meta interface{}) error {
+	zone := d.Get("zone").(string)
+	name := d.Get("name").(string)
+	recordType := d.Get("type").(string)
+
+	log.Printf("[DEBUG] Getting Akamai FastDNS record %s %s in zone: %s", name, recordType, zone)
+	record, err := findRecord(d, meta)
+	if err == akamaiNoRecordFound {
+		return fmt.Errorf("no %s record named %q found in Akamai FastDNS Zone (%s)", recordType, d.Get("fqdn").(string), zone)
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS record %s %s in zone (%s): %s", name, recordType, zone, err)
+	}
+
+	rdata := canonicalRecordSetRdata(record.Rdata, recordType)
+
+	d.SetId(recordId(zone, name, recordType))
+
+	if err := d.Set("rdata", rdata); err != nil {
+		return fmt.Errorf("error setting rdata: %s", err)
+	}
+	d.Set("ttl", record.TTL)
+
+	return nil
+}
+
+// canonicalRecordSetRdata returns the canonical, sorted rdata of a record
+// set. Data sources return it so their rdata can be wired into other
+// resources without depending on how Akamai happens to present or order it.
+func canonicalRecordSetRdata(recs []*string, typeStr string) []string {
+	rdata := cleanResourceRecords(recs, typeStr)
+	for i, r := range rdata {
+		rdata[i] = canonicalRdata(typeStr, r)
+	}
+	sort.Strings(rdata)
+	return rdata
+}
@@ -0,0 +1,88 @@
+package akamai
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAkamaiFastDNSRecord(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-dsrecord-%s.terraformtest.com", acctest.RandString(8))
+	dsName := "data.akamai_fastdns_record.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAkamaiFastDNSRecordConfig(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "fqdn", "vendor."+zoneName),
+					resource.TestCheckResourceAttr(dsName, "ttl", "300"),
+					resource.TestCheckResourceAttr(dsName, "rdata.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "rdata.0", "target.vendor.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAkamaiFastDNSRecord_notFound(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-dsrecord-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAkamaiFastDNSRecordConfig_notFound(zoneName),
+				ExpectError: regexp.MustCompile("no CNAME record named .* found"),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAkamaiFastDNSRecordConfig(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_record" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+  name = "vendor"
+  type = "CNAME"
+  ttl = 300
+  rdata = ["Target.Vendor.example.com."]
+}
+
+data "akamai_fastdns_record" "test" {
+  zone = "${akamai_fastdns_record.test.zone}"
+  name = "${akamai_fastdns_record.test.name}"
+  type = "CNAME"
+}
+`, zone)
+}
+
+func testAccDataSourceAkamaiFastDNSRecordConfig_notFound(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+data "akamai_fastdns_record" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+  name = "missing"
+  type = "CNAME"
+}
+`, zone)
+}
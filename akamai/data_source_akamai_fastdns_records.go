@@ -0,0 +1,199 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/terraform-provider-akamai/akamai/internal/dnsname"
+)
+
+func dataSourceAkamaiFastDNSRecords() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAkamaiFastDNSRecordsRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(append([]string{"SOA"}, fastDNSRecordTypes...), false),
+				},
+			},
+
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"search": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"rdata": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAkamaiFastDNSRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+
+	var types []string
+	for _, t := range d.Get("types").([]interface{}) {
+		types = append(types, t.(string))
+	}
+
+	opts := &akamai.ListZoneRecordSetOptions{
+		Types:    strings.Join(types, ","),
+		Search:   d.Get("search").(string),
+		PageSize: fastDNSListPageSize,
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	prefix := strings.ToLower(d.Get("name_prefix").(string))
+
+	recordSets, err := listFastDNSRecordSets(conn, zone, opts)
+	if err != nil {
+		return err
+	}
+
+	recordSets = filterFastDNSRecordSetsByName(recordSets, prefix, nameRegex)
+
+	keys := make([]string, 0, len(recordSets))
+	l := make([]interface{}, 0, len(recordSets))
+	for _, rs := range recordSets {
+		keys = append(keys, dnsname.Normalize(*rs.Name)+"/"+*rs.Type)
+		l = append(l, flattenFastDNSRecordSet(rs, zone))
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(zone+":"+strings.Join(keys, ","))))
+	if err := d.Set("record_sets", l); err != nil {
+		return fmt.Errorf("error setting record_sets: %s", err)
+	}
+
+	return nil
+}
+
+// listFastDNSRecordSets pages through every record set of zone matching
+// opts. Unless opts asks for another order, they are ordered by name and
+// type.
+func listFastDNSRecordSets(conn *akamai.Client, zone string, opts *akamai.ListZoneRecordSetOptions) ([]*akamai.RecordSet, error) {
+	var recordSets []*akamai.RecordSet
+
+	if opts.SortBy == "" {
+		opts.SortBy = "name,type"
+	}
+
+	for page := 1; ; page++ {
+		opts.Page = page
+
+		log.Printf("[DEBUG] Listing Akamai FastDNS Zone (%s) record sets, page %d", zone, page)
+		output, _, err := conn.FastDNSv2.GetZoneRecordSets(context.Background(), zone, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing Akamai FastDNS Zone (%s) record sets: %s", zone, err)
+		}
+
+		if output == nil || len(output.RecordSets) == 0 {
+			break
+		}
+		recordSets = append(recordSets, output.RecordSets...)
+
+		if output.Metadata == nil || output.Metadata.TotalElements == nil || len(recordSets) >= *output.Metadata.TotalElements {
+			break
+		}
+	}
+
+	return recordSets, nil
+}
+
+// filterFastDNSRecordSetsByName keeps the record sets whose fully qualified
+// name starts with prefix and matches nameRegex, when it is set.
+func filterFastDNSRecordSetsByName(recordSets []*akamai.RecordSet, prefix string, nameRegex *regexp.Regexp) []*akamai.RecordSet {
+	var filtered []*akamai.RecordSet
+	for _, rs := range recordSets {
+		if rs == nil || rs.Name == nil || rs.Type == nil {
+			continue
+		}
+
+		fqdn := dnsname.Normalize(*rs.Name)
+		if !strings.HasPrefix(fqdn, prefix) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(fqdn) {
+			continue
+		}
+
+		filtered = append(filtered, rs)
+	}
+	return filtered
+}
+
+func flattenFastDNSRecordSet(rs *akamai.RecordSet, zone string) map[string]interface{} {
+	m := map[string]interface{}{
+		"fqdn":  dnsname.Normalize(*rs.Name),
+		"name":  dnsname.Relative(*rs.Name, zone),
+		"type":  *rs.Type,
+		"rdata": canonicalRecordSetRdata(rs.Rdata, *rs.Type),
+	}
+	if rs.TTL != nil {
+		m["ttl"] = *rs.TTL
+	}
+	return m
+}
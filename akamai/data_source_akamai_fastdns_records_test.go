@@ -0,0 +1,121 @@
+package akamai
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+func TestFilterFastDNSRecordSetsByName(t *testing.T) {
+	names := []string{"porchetta.io", "_dmarc.porchetta.io", "*.porchetta.io", "WWW.porchetta.io."}
+	recordSets := make([]*akamai.RecordSet, 0, len(names)+1)
+	for i := range names {
+		recordSets = append(recordSets, &akamai.RecordSet{Name: &names[i], Type: &names[0]})
+	}
+	recordSets = append(recordSets, &akamai.RecordSet{})
+
+	cases := []struct {
+		Prefix   string
+		Regex    *regexp.Regexp
+		Expected []string
+	}{
+		{"", nil, names},
+		{"_", nil, []string{"_dmarc.porchetta.io"}},
+		{"www.", nil, []string{"WWW.porchetta.io."}},
+		{"", regexp.MustCompile(`^\*\.`), []string{"*.porchetta.io"}},
+		{"_", regexp.MustCompile(`^www`), nil},
+	}
+
+	for _, tc := range cases {
+		actual := filterFastDNSRecordSetsByName(recordSets, tc.Prefix, tc.Regex)
+		if len(actual) != len(tc.Expected) {
+			t.Fatalf("prefix: %q regex: %v\noutput: %d record sets, expected %v", tc.Prefix, tc.Regex, len(actual), tc.Expected)
+		}
+		for i, rs := range actual {
+			if *rs.Name != tc.Expected[i] {
+				t.Fatalf("prefix: %q regex: %v\noutput: %s, expected %s", tc.Prefix, tc.Regex, *rs.Name, tc.Expected[i])
+			}
+		}
+	}
+}
+
+func TestFlattenFastDNSRecordSet_canonicalRdata(t *testing.T) {
+	rs := testRecordSet("*.porchetta.io.", "CNAME", 300, "WEB.porchetta.io.")
+	m := flattenFastDNSRecordSet(rs, "porchetta.io")
+	if rdata := m["rdata"].([]string); len(rdata) != 1 || rdata[0] != "web.porchetta.io" {
+		t.Fatalf("output: %v", rdata)
+	}
+
+	// Both record data sources return the same rdata.
+	rs = testRecordSet("porchetta.io", "TXT", 300, `"v=spf1" " +all"`, `"google-site-verification=abc"`)
+	m = flattenFastDNSRecordSet(rs, "porchetta.io")
+	expected := canonicalRecordSetRdata(rs.Rdata, "TXT")
+	if !reflect.DeepEqual(m["rdata"], expected) {
+		t.Fatalf("output: %v, expected %v", m["rdata"], expected)
+	}
+	if expected[0] != canonicalRdata("TXT", `"google-site-verification=abc"`) {
+		t.Fatalf("expected sorted rdata, got %v", expected)
+	}
+}
+
+func TestAccDataSourceAkamaiFastDNSRecords(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-dsrecords-%s.terraformtest.com", acctest.RandString(8))
+	dsName := "data.akamai_fastdns_records.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAkamaiFastDNSRecordsConfig(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "record_sets.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "record_sets.0.name", "_dmarc"),
+					resource.TestCheckResourceAttr(dsName, "record_sets.0.fqdn", "_dmarc."+zoneName),
+					resource.TestCheckResourceAttr(dsName, "record_sets.0.type", "TXT"),
+					resource.TestCheckResourceAttr(dsName, "record_sets.0.rdata.0", "v=DMARC1; p=none"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAkamaiFastDNSRecordsConfig(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_record" "dmarc" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+  name = "_dmarc"
+  type = "TXT"
+  ttl = 300
+  rdata = ["v=DMARC1; p=none"]
+}
+
+resource "akamai_fastdns_record" "www" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+  name = "www"
+  type = "A"
+  ttl = 300
+  rdata = ["127.0.0.1"]
+}
+
+data "akamai_fastdns_records" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+  types = ["TXT"]
+  name_prefix = "_"
+
+  depends_on = ["akamai_fastdns_record.dmarc", "akamai_fastdns_record.www"]
+}
+`, zone)
+}
@@ -41,6 +41,47 @@ func dataSourceAkamaiFastDNSZone() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"sign_and_serve": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"masters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"target": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"alias_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"soa_serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -57,17 +98,48 @@ func dataSourceAkamaiFastDNSZoneRead(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[DEBUG] Getting Akamai FastDNS Hosted Zone: %s", input)
 
-	output, resp, err := conn.FastDNSv2.GetZone(context.Background(), input)
-	if err != nil || resp.StatusCode == 404 {
-		return fmt.Errorf("Error finding FastDNS Zone: %v", err)
+	output, resp, err := getZoneDetails(context.Background(), conn, input)
+	if resp != nil && resp.StatusCode == 404 {
+		return fmt.Errorf("Akamai FastDNS Zone (%s) not found", input)
+	}
+	if err != nil {
+		return fmt.Errorf("Error finding FastDNS Zone (%s): %v", input, err)
+	}
+	if output == nil || output.Zone == nil {
+		return fmt.Errorf("Akamai FastDNS Zone (%s) not found", input)
+	}
+
+	d.SetId(*output.Zone)
+	d.Set("zone", *output.Zone)
+	d.Set("comment", stringValue(output.Comment))
+	d.Set("type", stringValue(output.Type))
+	d.Set("contract_id", stringValue(output.ContractID))
+	d.Set("activation_state", stringValue(output.ActivationState))
+	d.Set("sign_and_serve", boolValue(output.SignAndServe))
+	d.Set("target", stringValue(output.Target))
+	d.Set("alias_count", intValue(output.AliasCount))
+	d.Set("version_id", stringValue(output.VersionId))
+	if err := d.Set("masters", flattenStringPointers(output.Masters)); err != nil {
+		return fmt.Errorf("error setting masters: %s", err)
 	}
 
-	d.SetId("zone")
-	d.Set("zone", output.Zone)
-	d.Set("comment", output.Comment)
-	d.Set("type", output.Type)
-	d.Set("contract_id", output.ContractID)
-	d.Set("activation_state", output.ActivationState)
+	// Secondary and alias zones don't have an SOA record we can read, and
+	// neither do primary zones that are still NEW.
+	if stringValue(output.Type) == "PRIMARY" && stringValue(output.ActivationState) != "NEW" {
+		soa, err := getFastDNSZoneSOA(conn, *output.Zone)
+		if err != nil {
+			return err
+		}
+		d.Set("soa_serial", soa.Serial)
+	}
+
+	ns, err := getFastDNSZoneDelegationSet(conn, &output.ZoneMetadata)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("name_servers", ns); err != nil {
+		return fmt.Errorf("error setting name_servers: %s", err)
+	}
 
 	return nil
 }
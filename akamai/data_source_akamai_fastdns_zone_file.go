@@ -0,0 +1,69 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAkamaiFastDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAkamaiFastDNSZoneFileRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"sort_records": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"strip_soa_serial": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"zone_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAkamaiFastDNSZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+
+	log.Printf("[DEBUG] Downloading Akamai FastDNS Zone (%s) master file", zone)
+	text, _, err := getZoneFile(context.Background(), conn, zone)
+	if err != nil {
+		return fmt.Errorf("error downloading Akamai FastDNS Zone (%s) master file: %s", zone, err)
+	}
+
+	sortRecords := d.Get("sort_records").(bool)
+	stripSerial := d.Get("strip_soa_serial").(bool)
+
+	// Only rewrite the master file when asked to, otherwise return it as
+	// Akamai sent it.
+	if sortRecords || stripSerial {
+		records, err := parseZoneFile(text, zone)
+		if err != nil {
+			return fmt.Errorf("error parsing Akamai FastDNS Zone (%s) master file: %s", zone, err)
+		}
+
+		text = strings.Join(canonicalZoneFileRecords(records, sortRecords, stripSerial), "\n") + "\n"
+	}
+
+	d.SetId(zone)
+	d.Set("zone_file", text)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package akamai
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAkamaiFastDNSZoneFile(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-export-%s.terraformtest.com", acctest.RandString(8))
+	dsName := "data.akamai_fastdns_zone_file.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAkamaiFastDNSZoneFileConfig(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dsName, "zone_file", regexp.MustCompile(` IN SOA \S+ \S+ 0 `)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAkamaiFastDNSZoneFileConfig(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+data "akamai_fastdns_zone_file" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+  sort_records = true
+  strip_soa_serial = true
+}
+`, zone)
+}
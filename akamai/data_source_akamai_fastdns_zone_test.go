@@ -2,13 +2,42 @@ package akamai
 
 import (
 	"fmt"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/akamai-sdk-go/akamai/credentials"
 )
 
+func TestDataSourceAkamaiFastDNSZone_new(t *testing.T) {
+	api := &fakeFastDNSZoneAPI{zone: "porchetta.io", activationState: "NEW"}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	cc := credentials.NewStaticCredentials("secret", "token", "access", "localhost")
+	conn, err := akamai.NewClient(srv.Client(), cc)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	conn.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	// A zone that was never bootstrapped has no SOA record to read yet.
+	d := schema.TestResourceDataRaw(t, dataSourceAkamaiFastDNSZone().Schema, map[string]interface{}{
+		"zone": api.zone,
+	})
+	if err := dataSourceAkamaiFastDNSZoneRead(d, &AkamaiClient{client: conn}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if v := d.Get("activation_state").(string); v != "NEW" {
+		t.Fatalf("expected activation_state NEW, got %q", v)
+	}
+}
+
 func TestAccDataSourceAkamaiFastDNSZone(t *testing.T) {
 	rInt := acctest.RandInt()
 	publicResourceName := "akamai_fastdns_zone.test"
@@ -54,6 +83,19 @@ func testAccDataSourceAkamaiFastDNSZoneCheck(rsName, dsName, zName string) resou
 			return fmt.Errorf("Akamai FastDNS Zone name is %q; want %q", attr["zone"], zName)
 		}
 
+		dsAttr := hostedZone.Primary.Attributes
+		if dsAttr["id"] != zName {
+			return fmt.Errorf("Akamai FastDNS Zone data source id is %q; want %q", dsAttr["id"], zName)
+		}
+
+		if dsAttr["soa_serial"] == "" || dsAttr["soa_serial"] == "0" {
+			return fmt.Errorf("Akamai FastDNS Zone data source has no soa_serial")
+		}
+
+		if dsAttr["name_servers.#"] == "" || dsAttr["name_servers.#"] == "0" {
+			return fmt.Errorf("Akamai FastDNS Zone data source has no name_servers")
+		}
+
 		return nil
 	}
 }
@@ -0,0 +1,168 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceAkamaiFastDNSZoneVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAkamaiFastDNSZoneVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"activated_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+
+			"activated_before": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"activation_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"last_activation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"activated_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"last_modified_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAkamaiFastDNSZoneVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+
+	var after, before time.Time
+	if v, ok := d.GetOk("activated_after"); ok {
+		after, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("activated_before"); ok {
+		before, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	log.Printf("[DEBUG] Listing Akamai FastDNS Zone versions: %s", zone)
+	output, _, err := listZoneVersions(context.Background(), conn, zone)
+	if err != nil {
+		return fmt.Errorf("error listing Akamai FastDNS Zone (%s) versions: %s", zone, err)
+	}
+
+	if output == nil {
+		return fmt.Errorf("no versions returned for Akamai FastDNS Zone (%s)", zone)
+	}
+
+	versions := filterZoneVersionsByActivationDate(output.Versions, after, before)
+
+	d.SetId(zone)
+	if err := d.Set("versions", flattenZoneVersions(versions)); err != nil {
+		return fmt.Errorf("error setting versions: %s", err)
+	}
+
+	return nil
+}
+
+// filterZoneVersionsByActivationDate returns the versions last activated
+// within the given range. A zero time leaves that end of the range open.
+// Versions that were never activated only match an entirely open range.
+func filterZoneVersionsByActivationDate(versions []*zoneVersion, after, before time.Time) []*zoneVersion {
+	if after.IsZero() && before.IsZero() {
+		return versions
+	}
+
+	filtered := make([]*zoneVersion, 0, len(versions))
+	for _, v := range versions {
+		if v == nil || v.LastActivationDate == nil {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, *v.LastActivationDate)
+		if err != nil {
+			log.Printf("[WARN] Unable to parse activation date %q of version %v", *v.LastActivationDate, v.VersionID)
+			continue
+		}
+
+		if !after.IsZero() && t.Before(after) {
+			continue
+		}
+		if !before.IsZero() && t.After(before) {
+			continue
+		}
+
+		filtered = append(filtered, v)
+	}
+	return filtered
+}
+
+func flattenZoneVersions(versions []*zoneVersion) []interface{} {
+	l := make([]interface{}, 0, len(versions))
+	for _, v := range versions {
+		if v == nil {
+			continue
+		}
+
+		m := map[string]interface{}{}
+		if v.VersionID != nil {
+			m["version_id"] = *v.VersionID
+		}
+		if v.ActivationState != nil {
+			m["activation_state"] = *v.ActivationState
+		}
+		if v.LastActivationDate != nil {
+			m["last_activation_date"] = *v.LastActivationDate
+		}
+		if v.LastModifiedBy != nil {
+			m["activated_by"] = *v.LastModifiedBy
+		}
+		if v.LastModifiedDate != nil {
+			m["last_modified_date"] = *v.LastModifiedDate
+		}
+		if v.Comment != nil {
+			m["comment"] = *v.Comment
+		}
+		l = append(l, m)
+	}
+	return l
+}
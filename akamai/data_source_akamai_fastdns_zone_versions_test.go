@@ -0,0 +1,77 @@
+package akamai
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestFilterZoneVersionsByActivationDate(t *testing.T) {
+	id1, id2, id3 := "v1", "v2", "v3"
+	d1, d2 := "2019-06-01T10:00:00Z", "2019-07-01T10:00:00Z"
+	versions := []*zoneVersion{
+		{VersionID: &id1, LastActivationDate: &d1},
+		{VersionID: &id2, LastActivationDate: &d2},
+		{VersionID: &id3},
+	}
+
+	june, _ := time.Parse(time.RFC3339, "2019-06-15T00:00:00Z")
+
+	cases := []struct {
+		After, Before time.Time
+		Expected      []string
+	}{
+		{time.Time{}, time.Time{}, []string{"v1", "v2", "v3"}},
+		{june, time.Time{}, []string{"v2"}},
+		{time.Time{}, june, []string{"v1"}},
+	}
+
+	for _, tc := range cases {
+		actual := filterZoneVersionsByActivationDate(versions, tc.After, tc.Before)
+		if len(actual) != len(tc.Expected) {
+			t.Fatalf("after: %s before: %s\noutput: %d versions, expected %v", tc.After, tc.Before, len(actual), tc.Expected)
+		}
+		for i, v := range actual {
+			if *v.VersionID != tc.Expected[i] {
+				t.Fatalf("after: %s before: %s\noutput: %s, expected %s", tc.After, tc.Before, *v.VersionID, tc.Expected[i])
+			}
+		}
+	}
+}
+
+func TestAccDataSourceAkamaiFastDNSZoneVersions(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-versions-%s.terraformtest.com", acctest.RandString(8))
+	dsName := "data.akamai_fastdns_zone_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAkamaiFastDNSZoneVersionsConfig(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "zone", zoneName),
+					resource.TestCheckResourceAttrSet(dsName, "versions.0.version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAkamaiFastDNSZoneVersionsConfig(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+data "akamai_fastdns_zone_versions" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+}
+`, zone)
+}
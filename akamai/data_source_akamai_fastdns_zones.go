@@ -0,0 +1,214 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+// fastDNSListPageSize is the number of items requested per page when
+// paging through Akamai list endpoints.
+const fastDNSListPageSize = 100
+
+func dataSourceAkamaiFastDNSZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAkamaiFastDNSZonesRead,
+		Schema: map[string]*schema.Schema{
+			"contract_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PRIMARY", "SECONDARY", "ALIAS"}, false),
+			},
+
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+			},
+
+			"search": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"contract_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"comment": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"activation_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"last_modified_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"last_activation_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAkamaiFastDNSZonesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AkamaiClient).client
+
+	opts := &akamai.ZoneListOptions{
+		ContractIDs: d.Get("contract_id").(string),
+		Types:       d.Get("type").(string),
+		Search:      d.Get("search").(string),
+		PageSize:    fastDNSListPageSize,
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	zones, err := listFastDNSZones(conn, opts)
+	if err != nil {
+		return err
+	}
+
+	zones = filterFastDNSZonesByName(zones, nameRegex)
+
+	names := make([]string, 0, len(zones))
+	l := make([]interface{}, 0, len(zones))
+	for _, z := range zones {
+		names = append(names, *z.Zone)
+		l = append(l, flattenFastDNSZone(z))
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(strings.Join(names, ","))))
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+	if err := d.Set("zones", l); err != nil {
+		return fmt.Errorf("error setting zones: %s", err)
+	}
+
+	return nil
+}
+
+// listFastDNSZones pages through every zone matching opts.
+func listFastDNSZones(conn *akamai.Client, opts *akamai.ZoneListOptions) ([]*akamai.Zone, error) {
+	var zones []*akamai.Zone
+
+	for page := 1; ; page++ {
+		opts.Page = page
+
+		log.Printf("[DEBUG] Listing Akamai FastDNS Zones, page %d", page)
+		output, _, err := conn.FastDNSv2.ListZones(context.Background(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing Akamai FastDNS Zones: %s", err)
+		}
+
+		if output == nil || len(output.Zones) == 0 {
+			break
+		}
+		zones = append(zones, output.Zones...)
+
+		if output.Metadata == nil || output.Metadata.TotalElements == nil || len(zones) >= *output.Metadata.TotalElements {
+			break
+		}
+	}
+
+	return zones, nil
+}
+
+// filterFastDNSZonesByName keeps the zones whose name matches nameRegex,
+// when it is set.
+func filterFastDNSZonesByName(zones []*akamai.Zone, nameRegex *regexp.Regexp) []*akamai.Zone {
+	var filtered []*akamai.Zone
+	for _, z := range zones {
+		if z == nil || z.Zone == nil {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(*z.Zone) {
+			continue
+		}
+
+		filtered = append(filtered, z)
+	}
+	return filtered
+}
+
+func flattenFastDNSZone(z *akamai.Zone) map[string]interface{} {
+	m := map[string]interface{}{
+		"zone": *z.Zone,
+	}
+	if z.Type != nil {
+		m["type"] = *z.Type
+	}
+	if z.ContractID != nil {
+		m["contract_id"] = *z.ContractID
+	}
+	if z.Comment != nil {
+		m["comment"] = *z.Comment
+	}
+	if z.ActivationState != nil {
+		m["activation_state"] = *z.ActivationState
+	}
+	if z.VersionID != nil {
+		m["version_id"] = *z.VersionID
+	}
+	if z.LastModifiedDate != nil {
+		m["last_modified_date"] = *z.LastModifiedDate
+	}
+	if z.LastActivationDate != nil {
+		m["last_activation_date"] = *z.LastActivationDate
+	}
+	return m
+}
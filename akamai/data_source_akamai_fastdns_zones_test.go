@@ -0,0 +1,122 @@
+package akamai
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/akamai-sdk-go/akamai/credentials"
+)
+
+func TestFilterFastDNSZonesByName(t *testing.T) {
+	names := []string{"porchetta.io", "staging.porchetta.io", "porchetta.com"}
+	zones := make([]*akamai.Zone, 0, len(names)+2)
+	for i := range names {
+		zones = append(zones, &akamai.Zone{Zone: &names[i]})
+	}
+	zones = append(zones, &akamai.Zone{}, nil)
+
+	cases := []struct {
+		Regex    *regexp.Regexp
+		Expected []string
+	}{
+		{nil, names},
+		{regexp.MustCompile(`\.io$`), []string{"porchetta.io", "staging.porchetta.io"}},
+		{regexp.MustCompile(`^staging\.`), []string{"staging.porchetta.io"}},
+		{regexp.MustCompile(`^www\.`), nil},
+	}
+
+	for _, tc := range cases {
+		actual := filterFastDNSZonesByName(zones, tc.Regex)
+		if len(actual) != len(tc.Expected) {
+			t.Fatalf("regex: %v\noutput: %d zones, expected %v", tc.Regex, len(actual), tc.Expected)
+		}
+		for i, z := range actual {
+			if *z.Zone != tc.Expected[i] {
+				t.Fatalf("regex: %v\noutput: %s, expected %s", tc.Regex, *z.Zone, tc.Expected[i])
+			}
+		}
+	}
+}
+
+func TestListFastDNSZones(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		pages = append(pages, r.URL.Query().Get("page"))
+
+		// Five zones, served two per page.
+		fmt.Fprint(w, `{"metadata": {"totalElements": 5}, "zones": [`)
+		for i := (page - 1) * 2; i < page*2 && i < 5; i++ {
+			if i > (page-1)*2 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"zone": "zone%d.porchetta.io"}`, i)
+		}
+		fmt.Fprint(w, `]}`)
+	}))
+	defer srv.Close()
+
+	cc := credentials.NewStaticCredentials("secret", "token", "access", "localhost")
+	conn, err := akamai.NewClient(srv.Client(), cc)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	conn.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	zones, err := listFastDNSZones(conn, &akamai.ZoneListOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if len(pages) != 3 {
+		t.Fatalf("expected 3 pages to be requested, got %v", pages)
+	}
+	if len(zones) != 5 || *zones[4].Zone != "zone4.porchetta.io" {
+		t.Fatalf("expected all 5 zones, got %d", len(zones))
+	}
+}
+
+func TestAccDataSourceAkamaiFastDNSZones(t *testing.T) {
+	rString := acctest.RandString(8)
+	zoneName := fmt.Sprintf("testzone-list-%s.terraformtest.com", rString)
+	dsName := "data.akamai_fastdns_zones.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAkamaiFastDNSZonesConfig(zoneName, rString),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "names.#", "1"),
+					resource.TestCheckResourceAttr(dsName, "names.0", zoneName),
+					resource.TestCheckResourceAttr(dsName, "zones.0.type", "PRIMARY"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAkamaiFastDNSZonesConfig(zone, rString string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+data "akamai_fastdns_zones" "test" {
+  contract_id = "${akamai_fastdns_zone.test.contract_id}"
+  type = "PRIMARY"
+  name_regex = "^testzone-list-%s\\."
+}
+`, zone, rString)
+}
@@ -0,0 +1,126 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAkamaiGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAkamaiGroupRead,
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"group_id"},
+			},
+
+			"contract_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"permissions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"group_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAkamaiGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AkamaiClient).client
+
+	log.Printf("[DEBUG] Listing Akamai groups")
+	output, _, err := listGroups(context.Background(), conn)
+	if err != nil {
+		return fmt.Errorf("error listing Akamai groups: %s", err)
+	}
+
+	var groups []*group
+	if output != nil {
+		groups = output.Groups
+	}
+
+	ids := make([]int, 0, len(groups))
+	idStrs := make([]string, 0, len(groups))
+	for _, g := range groups {
+		if g != nil && g.GroupID != nil {
+			ids = append(ids, *g.GroupID)
+			idStrs = append(idStrs, strconv.Itoa(*g.GroupID))
+		}
+	}
+	if err := d.Set("group_ids", ids); err != nil {
+		return fmt.Errorf("error setting group_ids: %s", err)
+	}
+
+	id, idOk := d.GetOk("group_id")
+	name, nameOk := d.GetOk("name")
+
+	// Without a group_id or name, only list the available groups.
+	if !idOk && !nameOk {
+		d.SetId(strings.Join(idStrs, ","))
+		return nil
+	}
+
+	var match *group
+	for _, g := range groups {
+		if g == nil || g.GroupID == nil {
+			continue
+		}
+		if idOk && *g.GroupID != id.(int) {
+			continue
+		}
+		if nameOk && (g.GroupName == nil || *g.GroupName != name.(string)) {
+			continue
+		}
+
+		if match != nil {
+			return fmt.Errorf("more than one Akamai group matched, use group_id to pick one")
+		}
+		match = g
+	}
+
+	if match == nil {
+		return fmt.Errorf("no matching Akamai group found")
+	}
+
+	d.SetId(strconv.Itoa(*match.GroupID))
+	d.Set("group_id", match.GroupID)
+	d.Set("name", match.GroupName)
+	if err := d.Set("contract_ids", flattenStringPointers(match.ContractIDs)); err != nil {
+		return fmt.Errorf("error setting contract_ids: %s", err)
+	}
+	if err := d.Set("permissions", flattenStringPointers(match.Permissions)); err != nil {
+		return fmt.Errorf("error setting permissions: %s", err)
+	}
+
+	return nil
+}
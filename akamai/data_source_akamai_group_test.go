@@ -0,0 +1,33 @@
+package akamai
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAkamaiGroup(t *testing.T) {
+	dsName := "data.akamai_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAkamaiGroupConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dsName, "group_id", "data.akamai_group.all", "group_ids.0"),
+					resource.TestCheckResourceAttrSet(dsName, "name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAkamaiGroupConfig = `
+data "akamai_group" "all" {}
+
+data "akamai_group" "test" {
+  group_id = "${data.akamai_group.all.group_ids[0]}"
+}
+`
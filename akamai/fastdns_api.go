@@ -0,0 +1,295 @@
+package akamai
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+// The akamai-sdk-go FastDNSv2Service does not cover every FastDNS v2 endpoint
+// we need. Until those land in the SDK, the requests are built here using the
+// SDK client so that they are signed and error checked the same way.
+
+// recordSetChange is a single record set change to add to a change list.
+type recordSetChange struct {
+	Name  string   `json:"name"`
+	Type  string   `json:"type"`
+	Op    string   `json:"op"`
+	TTL   int      `json:"ttl,omitempty"`
+	Rdata []string `json:"rdata,omitempty"`
+}
+
+// Operations supported when adding a record set change to a change list.
+const (
+	recordSetChangeAdd    = "ADD"
+	recordSetChangeEdit   = "EDIT"
+	recordSetChangeDelete = "DELETE"
+)
+
+// addChangeListRecordSetChange adds a single record set change to the
+// current change list of a zone.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#postchangelistrecordsetaddchange
+func addChangeListRecordSetChange(ctx context.Context, conn *akamai.Client, zone string, change *recordSetChange) (*akamai.Response, error) {
+	u := fmt.Sprintf("config-dns/v2/changelists/%v/recordsets/add-change", zone)
+
+	req, err := conn.NewRequest("POST", u, change)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.Do(ctx, req, nil)
+}
+
+// zoneVersion describes a single version of a zone.
+type zoneVersion struct {
+	VersionID          *string `json:"versionId,omitempty"`
+	ActivationState    *string `json:"activationState,omitempty"`
+	LastActivationDate *string `json:"lastActivationDate,omitempty"`
+	LastModifiedDate   *string `json:"lastModifiedDate,omitempty"`
+	LastModifiedBy     *string `json:"lastModifiedBy,omitempty"`
+	Comment            *string `json:"comment,omitempty"`
+}
+
+// zoneVersionList holds the response from listZoneVersions.
+type zoneVersionList struct {
+	Versions []*zoneVersion `json:"versions,omitempty"`
+}
+
+// listZoneVersions retrieves the version history of a zone.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#getzoneversions
+func listZoneVersions(ctx context.Context, conn *akamai.Client, zone string) (*zoneVersionList, *akamai.Response, error) {
+	u := fmt.Sprintf("config-dns/v2/zones/%v/versions?showAll=true", zone)
+
+	req, err := conn.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var vl *zoneVersionList
+	resp, err := conn.Do(ctx, req, &vl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return vl, resp, nil
+}
+
+// activateZoneVersion makes the record sets of a previous zone version the
+// current, active version of the zone.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#postversionrecordsetsactivate
+func activateZoneVersion(ctx context.Context, conn *akamai.Client, zone, version string) (*akamai.Response, error) {
+	u := fmt.Sprintf("config-dns/v2/zones/%v/versions/%v/recordsets/activate", zone, version)
+
+	req, err := conn.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return conn.Do(ctx, req, nil)
+}
+
+// getZoneFile downloads the zone as RFC 1035 master file text.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#getzonefile
+func getZoneFile(ctx context.Context, conn *akamai.Client, zone string) (string, *akamai.Response, error) {
+	u := fmt.Sprintf("config-dns/v2/zones/%v/zone-file", zone)
+
+	req, err := conn.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Accept", "text/dns")
+
+	buf := new(bytes.Buffer)
+	resp, err := conn.Do(ctx, req, buf)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return buf.String(), resp, nil
+}
+
+// uploadZoneFile replaces the records of the zone with the records in the
+// RFC 1035 master file text. The SDK only sends JSON bodies, so the request
+// is built and signed here.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#postzonefile
+func uploadZoneFile(ctx context.Context, conn *akamai.Client, zone, text string) (*akamai.Response, error) {
+	u, err := conn.BaseURL.Parse(fmt.Sprintf("config-dns/v2/zones/%v/zone-file", zone))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), strings.NewReader(text))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := akamai.NewSigner(conn.Credentials).Sign(req, nil); err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "text/dns")
+	req.Header.Set("User-Agent", conn.UserAgent)
+
+	resp, err := conn.Do(ctx, req, nil)
+	// Akamai may accept the upload and process it asynchronously.
+	if _, ok := err.(*akamai.AcceptedError); ok {
+		return resp, nil
+	}
+
+	return resp, err
+}
+
+// contractList holds the response from listContracts.
+type contractList struct {
+	Contracts []*akamai.Contract `json:"contracts,omitempty"`
+}
+
+// listContracts retrieves the contracts the credentials can manage zones in.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#getcontracts
+func listContracts(ctx context.Context, conn *akamai.Client) (*contractList, *akamai.Response, error) {
+	u := "config-dns/v2/data/contracts"
+
+	req, err := conn.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var cl *contractList
+	resp, err := conn.Do(ctx, req, &cl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cl, resp, nil
+}
+
+// group is an Akamai access control group.
+type group struct {
+	GroupID     *int      `json:"groupId,omitempty"`
+	GroupName   *string   `json:"groupName,omitempty"`
+	ContractIDs []*string `json:"contractIds,omitempty"`
+	Permissions []*string `json:"permissions,omitempty"`
+}
+
+// groupList holds the response from listGroups.
+type groupList struct {
+	Groups []*group `json:"groups,omitempty"`
+}
+
+// listGroups retrieves the groups the credentials have access to.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#getgroups
+func listGroups(ctx context.Context, conn *akamai.Client) (*groupList, *akamai.Response, error) {
+	u := "config-dns/v2/data/groups"
+
+	req, err := conn.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gl *groupList
+	resp, err := conn.Do(ctx, req, &gl)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gl, resp, nil
+}
+
+// zoneCreateOptions specifies the query parameters to createZone.
+type zoneCreateOptions struct {
+	ContractID string
+	GroupID    int
+}
+
+// createZone creates a new zone. Unlike FastDNSv2Service.CreateZone it can
+// place the zone in a specific group.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#postzones
+func createZone(ctx context.Context, conn *akamai.Client, opt *zoneCreateOptions, zone *akamai.ZoneCreateRequest) (*akamai.Zone, *akamai.Response, error) {
+	u := fmt.Sprintf("config-dns/v2/zones?contractId=%v&gid=%v", url.QueryEscape(opt.ContractID), opt.GroupID)
+
+	req, err := conn.NewRequest("POST", u, zone)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	z := new(akamai.Zone)
+	resp, err := conn.Do(ctx, req, &z)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return z, resp, nil
+}
+
+// zoneDetails holds the full response from getZoneDetails. The SDK's
+// ZoneMetadata leaves out the masters and target of secondary and alias
+// zones.
+type zoneDetails struct {
+	akamai.ZoneMetadata
+	Masters []*string `json:"masters,omitempty"`
+	Target  *string   `json:"target,omitempty"`
+}
+
+// getZoneDetails retrieves the settings of a single zone.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#getzone
+func getZoneDetails(ctx context.Context, conn *akamai.Client, zone string) (*zoneDetails, *akamai.Response, error) {
+	u := fmt.Sprintf("config-dns/v2/zones/%v", zone)
+
+	req, err := conn.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var z *zoneDetails
+	resp, err := conn.Do(ctx, req, &z)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return z, resp, nil
+}
+
+// contractAuthorities lists the name servers authoritative for the zones of
+// a contract.
+type contractAuthorities struct {
+	ContractID  *string   `json:"contractId,omitempty"`
+	Authorities []*string `json:"authorities,omitempty"`
+}
+
+// authoritiesList holds the response from listAuthorities.
+type authoritiesList struct {
+	Contracts []*contractAuthorities `json:"contracts,omitempty"`
+}
+
+// listAuthorities retrieves the authoritative name servers of a contract.
+//
+// Akamai API docs: https://developer.akamai.com/api/web_performance/fast_dns_zone_management/v2.html#getauthorities
+func listAuthorities(ctx context.Context, conn *akamai.Client, cid string) (*authoritiesList, *akamai.Response, error) {
+	u := fmt.Sprintf("config-dns/v2/data/authorities?contractIds=%v", url.QueryEscape(cid))
+
+	req, err := conn.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var al *authoritiesList
+	resp, err := conn.Do(ctx, req, &al)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return al, resp, nil
+}
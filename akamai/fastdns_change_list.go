@@ -0,0 +1,180 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/mutexkv"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/terraform-provider-akamai/akamai/internal/dnsname"
+)
+
+// fastDNSChangeListMutexKV serializes change list work per zone. A zone has
+// a single change list, so resources changing the same zone in parallel
+// would otherwise build and submit each other's changes.
+var fastDNSChangeListMutexKV = mutexkv.NewMutexKV()
+
+// lockFastDNSChangeList locks the change list of the zone and returns the
+// function that unlocks it.
+func lockFastDNSChangeList(zone string) func() {
+	key := dnsname.Normalize(zone)
+	fastDNSChangeListMutexKV.Lock(key)
+	return func() { fastDNSChangeListMutexKV.Unlock(key) }
+}
+
+// createFastDNSChangeList creates the change list of the zone, replacing an
+// existing one only when it is stale. It returns false when a change list
+// that isn't stale already exists, for example one still being edited in
+// Control Center, which is left alone.
+func createFastDNSChangeList(conn *akamai.Client, zone string) (bool, error) {
+	cli := &akamai.ChangeListOptions{
+		Zone:      zone,
+		Overwrite: "stale",
+	}
+
+	_, resp, err := conn.FastDNSv2.CreateChangeList(context.Background(), cli)
+	if resp != nil && resp.StatusCode == 409 {
+		log.Printf("[DEBUG] Akamai FastDNS change list for %s already exists, waiting for it to be submitted or discarded", zone)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error creating Akamai FastDNS change list: %s", err)
+	}
+
+	return true, nil
+}
+
+// recordSetKey identifies a record set within a zone by its fully
+// qualified name and type.
+func recordSetKey(fqdn, typeStr string) string {
+	return dnsname.Normalize(fqdn) + "/" + typeStr
+}
+
+// diffFastDNSRecordSets returns the changes turning the current record sets
+// into the desired ones: desired record sets that don't exist are added,
+// those that differ are edited and current record sets that aren't desired
+// are deleted. Deletes come first, so a name can change type in one go.
+func diffFastDNSRecordSets(current []*akamai.RecordSet, desired []*recordSetChange) []*recordSetChange {
+	existing := make(map[string]*akamai.RecordSet, len(current))
+	for _, rs := range current {
+		if rs == nil || rs.Name == nil || rs.Type == nil {
+			continue
+		}
+		existing[recordSetKey(*rs.Name, *rs.Type)] = rs
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	var deletes, edits, adds []*recordSetChange
+
+	for _, want := range desired {
+		key := recordSetKey(want.Name, want.Type)
+		wanted[key] = true
+
+		rs, ok := existing[key]
+		if !ok {
+			add := *want
+			add.Op = recordSetChangeAdd
+			adds = append(adds, &add)
+			continue
+		}
+
+		if intValue(rs.TTL) != want.TTL || !rdataEquivalent(want.Type, stringValues(rs.Rdata), want.Rdata) {
+			edit := *want
+			edit.Op = recordSetChangeEdit
+			edits = append(edits, &edit)
+		}
+	}
+
+	for key, rs := range existing {
+		if wanted[key] {
+			continue
+		}
+		deletes = append(deletes, &recordSetChange{
+			Name: dnsname.Normalize(*rs.Name),
+			Type: *rs.Type,
+			Op:   recordSetChangeDelete,
+		})
+	}
+	sort.Slice(deletes, func(i, j int) bool {
+		return recordSetKey(deletes[i].Name, deletes[i].Type) < recordSetKey(deletes[j].Name, deletes[j].Type)
+	})
+
+	changes := append(deletes, edits...)
+	return append(changes, adds...)
+}
+
+// applyFastDNSRecordSetChanges submits the changes returned by plan through
+// a single change list. plan is called again whenever the change list goes
+// stale, so it always works from the current records of the zone. Nothing
+// is submitted when there are no changes.
+func applyFastDNSRecordSetChanges(conn *akamai.Client, zone string, timeout time.Duration, plan func() ([]*recordSetChange, error)) error {
+	defer lockFastDNSChangeList(zone)()
+
+	wait := resource.StateChangeConf{
+		Pending:    []string{"stale", "exists"},
+		Target:     []string{"submitted"},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			changes, err := plan()
+			if err != nil {
+				return 42, "failure", err
+			}
+
+			if len(changes) == 0 {
+				log.Printf("[DEBUG] No record set changes for Akamai FastDNS Zone (%s)", zone)
+				return 42, "submitted", nil
+			}
+
+			created, err := createFastDNSChangeList(conn, zone)
+			if err != nil {
+				return 42, "failure", err
+			}
+			if !created {
+				return 42, "exists", nil
+			}
+
+			for _, c := range changes {
+				log.Printf("[DEBUG] Adding %s of %s %s to Akamai FastDNS change list: %s", c.Op, c.Name, c.Type, zone)
+				_, err = addChangeListRecordSetChange(context.Background(), conn, zone, c)
+				if err != nil {
+					conn.FastDNSv2.DeleteChangeList(context.Background(), zone)
+					e := fmt.Errorf("error adding %s of %s %s to Akamai FastDNS change list: %s", c.Op, c.Name, c.Type, err)
+					return 42, "failure", e
+				}
+			}
+
+			resp, err := conn.FastDNSv2.SubmitChangeList(context.Background(), zone)
+			// The zone changed underneath the change list, start over.
+			if resp != nil && resp.StatusCode == 409 {
+				log.Printf("[DEBUG] Akamai FastDNS change list for %s became stale before submit, retrying", zone)
+				return 42, "stale", nil
+			}
+
+			if err != nil {
+				conn.FastDNSv2.DeleteChangeList(context.Background(), zone)
+				e := fmt.Errorf("error submitting Akamai FastDNS change list: %s", err)
+				return 42, "failure", e
+			}
+
+			return 42, "submitted", nil
+		},
+	}
+
+	_, err := wait.WaitForState()
+	return err
+}
+
+func stringValues(l []*string) []string {
+	s := make([]string, 0, len(l))
+	for _, v := range l {
+		if v != nil {
+			s = append(s, *v)
+		}
+	}
+	return s
+}
@@ -0,0 +1,100 @@
+package akamai
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/akamai-sdk-go/akamai/credentials"
+)
+
+func testRecordSet(name, typeStr string, ttl int, rdata ...string) *akamai.RecordSet {
+	rs := &akamai.RecordSet{
+		Name: &name,
+		Type: &typeStr,
+		TTL:  &ttl,
+	}
+	for i := range rdata {
+		rs.Rdata = append(rs.Rdata, &rdata[i])
+	}
+	return rs
+}
+
+func TestDiffFastDNSRecordSets(t *testing.T) {
+	current := []*akamai.RecordSet{
+		testRecordSet("www.porchetta.io", "A", 300, "127.0.0.1"),
+		testRecordSet("mail.porchetta.io", "MX", 300, "10 mx1.porchetta.io."),
+		testRecordSet("old.porchetta.io", "A", 300, "127.0.0.9"),
+		testRecordSet("txt.porchetta.io", "TXT", 300, `"v=spf1 -all"`),
+		testRecordSet("api.porchetta.io", "A", 300, "127.0.0.5"),
+	}
+
+	desired := []*recordSetChange{
+		{Name: "WWW.porchetta.io", Type: "A", TTL: 300, Rdata: []string{"127.0.0.1"}},
+		{Name: "mail.porchetta.io", Type: "MX", TTL: 300, Rdata: []string{"10 MX1.porchetta.io"}},
+		{Name: "txt.porchetta.io", Type: "TXT", TTL: 300, Rdata: []string{flattenTxtEntry("v=spf1 -all")}},
+		{Name: "api.porchetta.io", Type: "A", TTL: 600, Rdata: []string{"127.0.0.5"}},
+		{Name: "api.porchetta.io", Type: "CNAME", TTL: 300, Rdata: []string{"www.porchetta.io"}},
+	}
+
+	changes := diffFastDNSRecordSets(current, desired)
+
+	expected := []string{
+		"DELETE old.porchetta.io A",
+		"EDIT api.porchetta.io A",
+		"ADD api.porchetta.io CNAME",
+	}
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d: %v", len(expected), len(changes), changes)
+	}
+	for i, c := range changes {
+		actual := c.Op + " " + c.Name + " " + c.Type
+		if actual != expected[i] {
+			t.Fatalf("change %d\noutput: %s\nexpected: %s", i, actual, expected[i])
+		}
+	}
+}
+
+func TestCreateFastDNSChangeList(t *testing.T) {
+	exists := true
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("overwrite") != "stale" {
+			t.Errorf("expected overwrite=stale, got %q", r.URL.RawQuery)
+		}
+		if exists {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"title": "Conflict", "status": 409}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"zone": "porchetta.io"}`)
+	}))
+	defer srv.Close()
+
+	cc := credentials.NewStaticCredentials("secret", "token", "access", "localhost")
+	conn, err := akamai.NewClient(srv.Client(), cc)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	conn.BaseURL, _ = url.Parse(srv.URL + "/")
+
+	created, err := createFastDNSChangeList(conn, "porchetta.io")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if created {
+		t.Fatal("expected an existing change list to be left alone")
+	}
+
+	exists = false
+	created, err = createFastDNSChangeList(conn, "porchetta.io")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !created {
+		t.Fatal("expected the change list to be created")
+	}
+}
@@ -0,0 +1,168 @@
+// Package dnsname handles the DNS names used by FastDNS resources: it
+// splits names into labels, converts between names relative to a zone and
+// fully qualified ones, and converts internationalized names to punycode.
+//
+// Names are returned in lower case ASCII without a trailing dot, which is
+// the form Akamai uses for record set names.
+package dnsname
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+const (
+	// MaxLength is the longest a name may be in presentation format,
+	// without its trailing dot.
+	MaxLength = 253
+
+	// MaxLabelLength is the longest a single label may be.
+	MaxLabelLength = 63
+
+	// Apex is the relative name of the apex of a zone.
+	Apex = "@"
+)
+
+// Labels splits name into its labels. A trailing dot is ignored and dots
+// escaped with a backslash don't separate labels.
+func Labels(name string) []string {
+	name = trimDot(name)
+	if name == "" {
+		return nil
+	}
+
+	var labels []string
+	start := 0
+	for i := 0; i < len(name); i++ {
+		switch name[i] {
+		case '\\':
+			i++
+		case '.':
+			labels = append(labels, name[start:i])
+			start = i + 1
+		}
+	}
+	return append(labels, name[start:])
+}
+
+// Normalize returns name in lower case without its trailing dot, with
+// internationalized labels converted to punycode. Labels that can't be
+// converted are only lower cased, Validate reports them.
+func Normalize(name string) string {
+	labels := Labels(name)
+	for i, l := range labels {
+		if a, err := toASCII(l); err == nil {
+			labels[i] = a
+		} else {
+			labels[i] = strings.ToLower(l)
+		}
+	}
+	return strings.Join(labels, ".")
+}
+
+// Validate checks that name, after conversion to ASCII, is within the
+// length limits for names and labels and has no empty labels.
+func Validate(name string) error {
+	labels := Labels(name)
+	if len(labels) == 0 {
+		return fmt.Errorf("name is empty")
+	}
+
+	for i, l := range labels {
+		if l == "" {
+			return fmt.Errorf("name %q has an empty label", name)
+		}
+
+		a, err := toASCII(l)
+		if err != nil {
+			return fmt.Errorf("label %q of name %q is not a valid internationalized label: %s", l, name, err)
+		}
+		if len(a) > MaxLabelLength {
+			return fmt.Errorf("label %q of name %q is longer than %d characters", a, name, MaxLabelLength)
+		}
+		labels[i] = a
+	}
+
+	if n := strings.Join(labels, "."); len(n) > MaxLength {
+		return fmt.Errorf("name %q is longer than %d characters", n, MaxLength)
+	}
+	return nil
+}
+
+// IsSubdomain reports whether name is zone or a name below it. Names are
+// compared label by label, so "notexample.com" is not below "example.com".
+func IsSubdomain(name, zone string) bool {
+	n := Labels(Normalize(name))
+	z := Labels(Normalize(zone))
+	if len(z) > len(n) {
+		return false
+	}
+
+	offset := len(n) - len(z)
+	for i := range z {
+		if n[offset+i] != z[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Absolute returns the fully qualified form of name in zone. Empty names
+// and "@" are the apex, and names already within the zone are kept as
+// they are, so both relative and qualified spellings can be used.
+func Absolute(name, zone string) string {
+	n := Normalize(name)
+	z := Normalize(zone)
+
+	switch {
+	case n == "" || n == Apex:
+		return z
+	case IsSubdomain(n, z):
+		return n
+	default:
+		return n + "." + z
+	}
+}
+
+// Relative returns name relative to zone, "@" for the apex of the zone.
+// Names outside of the zone are returned fully qualified with a trailing
+// dot.
+func Relative(name, zone string) string {
+	n := Normalize(name)
+	z := Normalize(zone)
+
+	switch {
+	case n == z:
+		return Apex
+	case IsSubdomain(n, z):
+		return strings.TrimSuffix(n, "."+z)
+	default:
+		return n + "."
+	}
+}
+
+// Equal reports whether two names are the same, ignoring case, trailing
+// dots and the encoding of internationalized labels.
+func Equal(a, b string) bool {
+	return Normalize(a) == Normalize(b)
+}
+
+// toASCII converts a single label to its ASCII form. ASCII labels are only
+// lower cased, so names with underscores and wildcards pass unchanged.
+func toASCII(label string) (string, error) {
+	for i := 0; i < len(label); i++ {
+		if label[i] >= 0x80 {
+			return idna.Lookup.ToASCII(label)
+		}
+	}
+	return strings.ToLower(label), nil
+}
+
+func trimDot(name string) string {
+	if strings.HasSuffix(name, ".") && !strings.HasSuffix(name, `\.`) {
+		return name[:len(name)-1]
+	}
+	return name
+}
@@ -0,0 +1,133 @@
+package dnsname
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLabels(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Output []string
+	}{
+		{"www.porchetta.io", []string{"www", "porchetta", "io"}},
+		{"www.porchetta.io.", []string{"www", "porchetta", "io"}},
+		{`first\.last.porchetta.io`, []string{`first\.last`, "porchetta", "io"}},
+		{"_sip._tcp", []string{"_sip", "_tcp"}},
+		{"", nil},
+		{".", nil},
+	}
+
+	for _, tc := range cases {
+		actual := Labels(tc.Input)
+		if !reflect.DeepEqual(actual, tc.Output) {
+			t.Fatalf("input: %s\noutput: %#v", tc.Input, actual)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		Input, Output string
+	}{
+		{"WWW.Porchetta.io.", "www.porchetta.io"},
+		{"_DMARC", "_dmarc"},
+		{"*.porchetta.io", "*.porchetta.io"},
+		{"bücher.porchetta.io", "xn--bcher-kva.porchetta.io"},
+		{"xn--bcher-kva.porchetta.io", "xn--bcher-kva.porchetta.io"},
+		{"@", "@"},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		actual := Normalize(tc.Input)
+		if actual != tc.Output {
+			t.Fatalf("input: %s\noutput: %s", tc.Input, actual)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{"www.porchetta.io", true},
+		{"_acme-challenge.porchetta.io.", true},
+		{"bücher.porchetta.io", true},
+		{strings.Repeat("a", 63) + ".porchetta.io", true},
+		{strings.Repeat("a", 64) + ".porchetta.io", false},
+		{strings.Repeat("abcdefg.", 32) + "io", false},
+		{"www..porchetta.io", false},
+		{"", false},
+	}
+
+	for _, tc := range cases {
+		err := Validate(tc.Input)
+		if (err == nil) != tc.Valid {
+			t.Fatalf("input: %s\nerror: %v", tc.Input, err)
+		}
+	}
+}
+
+func TestIsSubdomain(t *testing.T) {
+	cases := []struct {
+		Name, Zone string
+		Output     bool
+	}{
+		{"www.porchetta.io", "porchetta.io", true},
+		{"porchetta.io", "porchetta.io.", true},
+		{"WWW.PORCHETTA.IO.", "porchetta.io", true},
+		{"notporchetta.io", "porchetta.io", false},
+		{"porchetta.io", "www.porchetta.io", false},
+		{"www.bücher.io", "xn--bcher-kva.io", true},
+	}
+
+	for _, tc := range cases {
+		actual := IsSubdomain(tc.Name, tc.Zone)
+		if actual != tc.Output {
+			t.Fatalf("name: %s zone: %s\noutput: %t", tc.Name, tc.Zone, actual)
+		}
+	}
+}
+
+func TestAbsolute(t *testing.T) {
+	cases := []struct {
+		Input, Output string
+	}{
+		{"www", "www.porchetta.io"},
+		{"www.", "www.porchetta.io"},
+		{"", "porchetta.io"},
+		{"@", "porchetta.io"},
+		{"porchetta.io", "porchetta.io"},
+		{"test.porchetta.io.", "test.porchetta.io"},
+		{"notporchetta.io", "notporchetta.io.porchetta.io"},
+		{"bücher", "xn--bcher-kva.porchetta.io"},
+	}
+
+	for _, tc := range cases {
+		actual := Absolute(tc.Input, "porchetta.io.")
+		if actual != tc.Output {
+			t.Fatalf("input: %s\noutput: %s", tc.Input, actual)
+		}
+	}
+}
+
+func TestRelative(t *testing.T) {
+	cases := []struct {
+		Input, Output string
+	}{
+		{"www.porchetta.io", "www"},
+		{"_sip._tcp.porchetta.io.", "_sip._tcp"},
+		{"porchetta.io", "@"},
+		{"www.example.com", "www.example.com."},
+	}
+
+	for _, tc := range cases {
+		actual := Relative(tc.Input, "porchetta.io")
+		if actual != tc.Output {
+			t.Fatalf("input: %s\noutput: %s", tc.Input, actual)
+		}
+	}
+}
@@ -41,11 +41,24 @@ func Provider() terraform.ResourceProvider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"akamai_fastdns_zone":   resourceAkamaiFastDNSZone(),
-			"akamai_fastdns_record": resourceAkamaiFastDNSRecord(),
+			"akamai_fastdns_zone":                    resourceAkamaiFastDNSZone(),
+			"akamai_fastdns_record":                  resourceAkamaiFastDNSRecord(),
+			"akamai_fastdns_soa":                     resourceAkamaiFastDNSSOA(),
+			"akamai_fastdns_zone_version_activation": resourceAkamaiFastDNSZoneVersionActivation(),
+			"akamai_fastdns_zone_file":               resourceAkamaiFastDNSZoneFile(),
+			"akamai_fastdns_zone_records":            resourceAkamaiFastDNSZoneRecords(),
+			"akamai_fastdns_record_sets":             resourceAkamaiFastDNSRecordSets(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"akamai_fastdns_zone": dataSourceAkamaiFastDNSZone(),
+			"akamai_fastdns_zone":          dataSourceAkamaiFastDNSZone(),
+			"akamai_fastdns_zone_versions": dataSourceAkamaiFastDNSZoneVersions(),
+			"akamai_fastdns_zone_file":     dataSourceAkamaiFastDNSZoneFile(),
+			"akamai_fastdns_zones":         dataSourceAkamaiFastDNSZones(),
+			"akamai_fastdns_nameservers":   dataSourceAkamaiFastDNSNameServers(),
+			"akamai_fastdns_record":        dataSourceAkamaiFastDNSRecord(),
+			"akamai_fastdns_records":       dataSourceAkamaiFastDNSRecords(),
+			"akamai_contract":              dataSourceAkamaiContract(),
+			"akamai_group":                 dataSourceAkamaiGroup(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
@@ -0,0 +1,391 @@
+package akamai
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+// rdataCanonicalizers normalize a single rdata entry of a record type, so
+// that values which only differ in presentation compare equal. Types
+// without an entry only have their whitespace normalized.
+var rdataCanonicalizers = map[string]func(string) string{
+	akamai.RRTypeA:     canonicalIPRdata,
+	akamai.RRTypeAaaa:  canonicalIPRdata,
+	akamai.RRTypeCname: canonicalDomainName,
+	akamai.RRTypeNs:    canonicalDomainName,
+	akamai.RRTypePtr:   canonicalDomainName,
+	akamai.RRTypeMx:    canonicalFieldsRdata(nil, []int{1}),
+	akamai.RRTypeSrv:   canonicalFieldsRdata(nil, []int{3}),
+	akamai.RRTypeNaptr: canonicalFieldsRdata([]int{2}, []int{5}),
+	akamai.RRTypeCaa:   canonicalCaaRdata,
+	akamai.RRTypeTxt:   canonicalTxtRdata,
+	akamai.RRTypeSpf:   canonicalTxtRdata,
+
+	akamai.RRTypeAfsdb:     canonicalFieldsRdata(nil, []int{1}),
+	akamai.RRTypeRp:        canonicalFieldsRdata(nil, []int{0, 1}),
+	akamai.RRTypeHinfo:     canonicalQuotedRdata,
+	akamai.RRTypeLoc:       canonicalLocRdata,
+	akamai.RRTypeSshfp:     canonicalBlobRdata(2, true),
+	akamai.RRTypeTlsa:      canonicalBlobRdata(3, true),
+	akamai.RRTypeAkamaiCdn: canonicalDomainName,
+	akamai.RRTypeAkamaiTlc: canonicalAkamaiTlcRdata,
+	rrTypeDnskey:           canonicalBlobRdata(3, false),
+	rrTypeDs:               canonicalBlobRdata(3, true),
+	rrTypeCert:             canonicalBlobRdata(3, false),
+	rrTypeRrsig:            canonicalRrsigRdata,
+	rrTypeNsec3:            canonicalNsec3Rdata,
+	rrTypeNsec3param:       canonicalFieldsRdata([]int{3}, nil),
+	rrTypeHttps:            canonicalSvcbRdata,
+	rrTypeSvcb:             canonicalSvcbRdata,
+}
+
+// canonicalRdata returns the canonical form of a single rdata entry.
+func canonicalRdata(typeStr, s string) string {
+	if f, ok := rdataCanonicalizers[typeStr]; ok {
+		return f(s)
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// rdataEquivalent reports whether two lists of rdata hold the same values
+// for the record type, regardless of order or presentation.
+func rdataEquivalent(typeStr string, a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	ca := make([]string, len(a))
+	cb := make([]string, len(b))
+	for i := range a {
+		ca[i] = canonicalRdata(typeStr, a[i])
+		cb[i] = canonicalRdata(typeStr, b[i])
+	}
+	sort.Strings(ca)
+	sort.Strings(cb)
+
+	for i := range ca {
+		if ca[i] != cb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// canonicalDomainName lower cases a domain name and removes the trailing dot.
+func canonicalDomainName(s string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(s), "."))
+}
+
+// canonicalIPRdata renders IPv4 addresses in dotted decimal and IPv6
+// addresses in their compressed, lower case form.
+func canonicalIPRdata(s string) string {
+	s = strings.TrimSpace(s)
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return s
+	}
+	return ip.String()
+}
+
+// canonicalFieldsRdata returns a canonicalizer for rdata made up of
+// whitespace separated fields. Fields in lower are lower cased and fields
+// in names are treated as domain names.
+func canonicalFieldsRdata(lower, names []int) func(string) string {
+	return func(s string) string {
+		fields := strings.Fields(s)
+		for _, i := range lower {
+			if i < len(fields) {
+				fields[i] = strings.ToLower(fields[i])
+			}
+		}
+		for _, i := range names {
+			if i < len(fields) && fields[i] != "." {
+				fields[i] = canonicalDomainName(fields[i])
+			}
+		}
+		return strings.Join(fields, " ")
+	}
+}
+
+// canonicalCaaRdata lower cases the tag of a CAA record and makes sure its
+// value is quoted.
+func canonicalCaaRdata(s string) string {
+	fields := strings.SplitN(strings.TrimSpace(s), " ", 3)
+	if len(fields) != 3 {
+		return s
+	}
+
+	value := strings.TrimSpace(fields[2])
+	if !strings.HasPrefix(value, `"`) {
+		value = `"` + value + `"`
+	}
+	return strings.Join([]string{fields[0], strings.ToLower(fields[1]), value}, " ")
+}
+
+// canonicalTxtRdata strips the quotes around a TXT or SPF value, so quoted
+// and unquoted spellings compare equal.
+func canonicalTxtRdata(s string) string {
+	return expandTxtEntry(strings.TrimSpace(s))
+}
+
+// canonicalBlobRdata returns a canonicalizer for rdata that ends in a
+// base64 or hex value after fixed fields. The value may be split by
+// whitespace, so its parts are joined, and hex values are lower cased.
+func canonicalBlobRdata(fixed int, lower bool) func(string) string {
+	return func(s string) string {
+		fields := strings.Fields(s)
+		if len(fields) <= fixed {
+			return strings.Join(fields, " ")
+		}
+
+		blob := strings.Join(fields[fixed:], "")
+		if lower {
+			blob = strings.ToLower(blob)
+		}
+		return strings.Join(append(fields[:fixed], blob), " ")
+	}
+}
+
+// canonicalQuotedRdata quotes every character-string of the rdata.
+func canonicalQuotedRdata(s string) string {
+	fields, err := rdataFields(s)
+	if err != nil {
+		return strings.Join(strings.Fields(s), " ")
+	}
+	for i, f := range fields {
+		fields[i] = quoteCharacterString(f)
+	}
+	return strings.Join(fields, " ")
+}
+
+// canonicalLocRdata fills in the optional minutes, seconds, size and
+// precision fields of a LOC record with their defaults and drops the
+// meter suffixes.
+func canonicalLocRdata(s string) string {
+	fields := strings.Fields(s)
+	out := make([]string, 0, 12)
+
+	i := 0
+	for _, dirs := range []string{"NS", "EW"} {
+		parts := []string{"0", "0", "0"}
+		for j := 0; j < 3 && i < len(fields) && !isLocDirection(fields[i], dirs); j++ {
+			parts[j] = fields[i]
+			i++
+		}
+		if i >= len(fields) || !isLocDirection(fields[i], dirs) {
+			return strings.Join(fields, " ")
+		}
+		for _, p := range parts {
+			out = append(out, canonicalNumber(p))
+		}
+		out = append(out, strings.ToUpper(fields[i]))
+		i++
+	}
+
+	if i >= len(fields) {
+		return strings.Join(fields, " ")
+	}
+	for j, def := range []string{"", "1", "10000", "10"} {
+		v := def
+		if i+j < len(fields) {
+			v = fields[i+j]
+		}
+		out = append(out, canonicalNumber(strings.TrimSuffix(v, "m")))
+	}
+
+	return strings.Join(out, " ")
+}
+
+func isLocDirection(s, dirs string) bool {
+	return len(s) == 1 && strings.Contains(dirs, strings.ToUpper(s))
+}
+
+// canonicalNumber renders a decimal number without insignificant zeros.
+func canonicalNumber(s string) string {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return s
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// canonicalRrsigRdata upper cases the type covered, canonicalizes the
+// signer name and joins the signature.
+func canonicalRrsigRdata(s string) string {
+	fields := strings.Fields(canonicalBlobRdata(8, false)(s))
+	if len(fields) < 8 {
+		return strings.Join(fields, " ")
+	}
+
+	fields[0] = strings.ToUpper(fields[0])
+	if fields[7] != "." {
+		fields[7] = canonicalDomainName(fields[7])
+	}
+	return strings.Join(fields, " ")
+}
+
+// canonicalNsec3Rdata lower cases the salt and next hashed owner name and
+// sorts the type bitmap.
+func canonicalNsec3Rdata(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) < 5 {
+		return strings.Join(fields, " ")
+	}
+
+	fields[3] = strings.ToLower(fields[3])
+	fields[4] = strings.ToLower(fields[4])
+	types := fields[5:]
+	for i := range types {
+		types[i] = strings.ToUpper(types[i])
+	}
+	sort.Strings(types)
+	return strings.Join(fields, " ")
+}
+
+// canonicalSvcbRdata canonicalizes the target name of an SVCB or HTTPS
+// record and sorts its parameters.
+func canonicalSvcbRdata(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) < 2 {
+		return strings.Join(fields, " ")
+	}
+
+	if fields[1] != "." {
+		fields[1] = canonicalDomainName(fields[1])
+	}
+	sort.Strings(fields[2:])
+	return strings.Join(fields, " ")
+}
+
+// canonicalAkamaiTlcRdata upper cases the answer type of an AKAMAITLC
+// record and canonicalizes its name.
+func canonicalAkamaiTlcRdata(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return strings.Join(fields, " ")
+	}
+	return strings.ToUpper(fields[0]) + " " + canonicalDomainName(fields[1])
+}
+
+// recordTypeBlocks maps record types to the typed block that may be used
+// instead of rdata strings for them.
+var recordTypeBlocks = map[string]string{
+	akamai.RRTypeMx:    "mx",
+	akamai.RRTypeSrv:   "srv",
+	akamai.RRTypeCaa:   "caa",
+	akamai.RRTypeNaptr: "naptr",
+}
+
+// recordBlockRdata renders typed record blocks as presentation format
+// rdata strings.
+func recordBlockRdata(typeStr string, blocks []interface{}) []string {
+	rdata := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		m := b.(map[string]interface{})
+
+		var s string
+		switch typeStr {
+		case akamai.RRTypeMx:
+			s = fmt.Sprintf("%d %s", m["preference"].(int), m["exchange"].(string))
+		case akamai.RRTypeSrv:
+			s = fmt.Sprintf("%d %d %d %s", m["priority"].(int), m["weight"].(int), m["port"].(int), m["target"].(string))
+		case akamai.RRTypeCaa:
+			s = fmt.Sprintf("%d %s %s", m["flags"].(int), m["tag"].(string), quoteCharacterString(m["value"].(string)))
+		case akamai.RRTypeNaptr:
+			s = fmt.Sprintf("%d %d %s %s %s %s", m["order"].(int), m["preference"].(int),
+				quoteCharacterString(m["flags"].(string)), quoteCharacterString(m["service"].(string)),
+				quoteCharacterString(m["regexp"].(string)), m["replacement"].(string))
+		}
+		rdata = append(rdata, s)
+	}
+	return rdata
+}
+
+// flattenRecordBlocks parses presentation format rdata strings into typed
+// record blocks.
+func flattenRecordBlocks(typeStr string, rdata []string) ([]interface{}, error) {
+	blocks := make([]interface{}, 0, len(rdata))
+	for _, r := range rdata {
+		fields, err := rdataFields(r)
+		if err != nil {
+			return nil, err
+		}
+
+		var m map[string]interface{}
+		switch typeStr {
+		case akamai.RRTypeMx:
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("invalid MX rdata: %q", r)
+			}
+			m = map[string]interface{}{
+				"preference": atoiOrZero(fields[0]),
+				"exchange":   fields[1],
+			}
+		case akamai.RRTypeSrv:
+			if len(fields) != 4 {
+				return nil, fmt.Errorf("invalid SRV rdata: %q", r)
+			}
+			m = map[string]interface{}{
+				"priority": atoiOrZero(fields[0]),
+				"weight":   atoiOrZero(fields[1]),
+				"port":     atoiOrZero(fields[2]),
+				"target":   fields[3],
+			}
+		case akamai.RRTypeCaa:
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("invalid CAA rdata: %q", r)
+			}
+			m = map[string]interface{}{
+				"flags": atoiOrZero(fields[0]),
+				"tag":   fields[1],
+				"value": fields[2],
+			}
+		case akamai.RRTypeNaptr:
+			if len(fields) != 6 {
+				return nil, fmt.Errorf("invalid NAPTR rdata: %q", r)
+			}
+			m = map[string]interface{}{
+				"order":       atoiOrZero(fields[0]),
+				"preference":  atoiOrZero(fields[1]),
+				"flags":       fields[2],
+				"service":     fields[3],
+				"regexp":      fields[4],
+				"replacement": fields[5],
+			}
+		default:
+			return nil, fmt.Errorf("record type %s has no typed block", typeStr)
+		}
+		blocks = append(blocks, m)
+	}
+	return blocks, nil
+}
+
+// rdataFields splits presentation format rdata into its fields, removing
+// the quotes around character-strings and undoing their escapes.
+func rdataFields(s string) ([]string, error) {
+	lines, err := splitZoneFileLines(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	for _, l := range lines {
+		for _, t := range l.tokens {
+			if len(t) >= 2 && strings.HasPrefix(t, `"`) && strings.HasSuffix(t, `"`) {
+				t = expandTxtEntry(t)
+			}
+			fields = append(fields, t)
+		}
+	}
+	return fields, nil
+}
+
+func atoiOrZero(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
@@ -0,0 +1,178 @@
+package akamai
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCanonicalRdata(t *testing.T) {
+	cases := []struct {
+		Type, A, B string
+		Equal      bool
+	}{
+		{"A", "127.0.0.1", " 127.0.0.1 ", true},
+		{"A", "127.0.0.1", "127.0.0.2", false},
+		{"AAAA", "2001:0db8:0000:0000:0000:0000:0000:0001", "2001:db8::1", true},
+		{"AAAA", "2001:DB8::1", "2001:db8::1", true},
+		{"AAAA", "2001:db8::1", "2001:db8::2", false},
+		{"CNAME", "Web.Porchetta.io.", "web.porchetta.io", true},
+		{"CNAME", "web.porchetta.io", "www.porchetta.io", false},
+		{"NS", "a1-49.akam.net.", "a1-49.akam.net", true},
+		{"PTR", "host.porchetta.io.", "HOST.porchetta.io", true},
+		{"MX", "10 MX1.porchetta.io.", "10  mx1.porchetta.io", true},
+		{"MX", "10 mx1.porchetta.io", "20 mx1.porchetta.io", false},
+		{"SRV", "10 60 5060 SIP.porchetta.io.", "10 60 5060 sip.porchetta.io", true},
+		{"SRV", "10 60 5060 sip.porchetta.io", "10 60 5061 sip.porchetta.io", false},
+		{"NAPTR", `100 10 "s" "SIP+D2U" "" _sip._udp.porchetta.io.`, `100 10 "S" "SIP+D2U" "" _sip._udp.porchetta.io`, true},
+		{"CAA", `0 ISSUE "letsencrypt.org"`, `0 issue "letsencrypt.org"`, true},
+		{"CAA", `0 issue letsencrypt.org`, `0 issue "letsencrypt.org"`, true},
+		{"CAA", `0 issue "letsencrypt.org"`, `0 issue "digicert.com"`, false},
+		{"TXT", `"v=spf1 -all"`, "v=spf1 -all", true},
+		{"TXT", "v=spf1 -all", "v=spf1 ~all", false},
+		{"SPF", `"v=spf1 -all"`, "v=spf1 -all", true},
+		{"AFSDB", "1 AFSDB.porchetta.io.", "1 afsdb.porchetta.io", true},
+		{"RP", "Admin.porchetta.io. txt.porchetta.io.", "admin.porchetta.io txt.porchetta.io", true},
+		{"HINFO", `"INTEL-386" "Unix"`, `INTEL-386 Unix`, true},
+		{"HINFO", `"INTEL-386" "Unix"`, `"INTEL-386" "Linux"`, false},
+		{"LOC", "52 22 23.000 N 4 53 32.000 E -2.00m 0.00m 10000m 10m", "52 22 23 n 4 53 32 e -2 0", true},
+		{"LOC", "52 N 4 E 10m", "52 0 0.000 N 4 0 0 E 10.00m 1m 10000m 10m", true},
+		{"LOC", "52 N 4 E 10m", "52 N 4 E 11m", false},
+		{"SSHFP", "1 1 ABCDEF01", "1 1 abcd ef01", true},
+		{"TLSA", "3 1 1 ABCDEF01", "3 1 1 abcdef01", true},
+		{"DS", "60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118", "60485 5 1 2bb183af5f22588179a53b0a98631fad1a292118", true},
+		{"DNSKEY", "257 3 8 AwEAAa fI2M=", "257 3 8 AwEAAafI2M=", true},
+		{"DNSKEY", "257 3 8 AwEAAafI2M=", "257 3 8 awEAAafI2M=", false},
+		{"CERT", "PGP 0 0 AAAA BBBB", "PGP 0 0 AAAABBBB", true},
+		{"RRSIG", "a 8 3 300 20190801000000 20190701000000 12345 Porchetta.io. abc def", "A 8 3 300 20190801000000 20190701000000 12345 porchetta.io abcdef", true},
+		{"NSEC3", "1 0 10 AABB 2T7B4G4VSA5SMI47K61MV5BV1A22BOJR NS A", "1 0 10 aabb 2t7b4g4vsa5smi47k61mv5bv1a22bojr a ns", true},
+		{"NSEC3PARAM", "1 0 10 AABB", "1 0 10 aabb", true},
+		{"HTTPS", "1 Svc.porchetta.io. port=443 alpn=h2", "1 svc.porchetta.io alpn=h2 port=443", true},
+		{"SVCB", "0 .", "0 .", true},
+		{"AKAMAICDN", "Www.porchetta.io.edgekey.net", "www.porchetta.io.edgekey.net.", true},
+		{"AKAMAITLC", "dual www.porchetta.io.", "DUAL www.porchetta.io", true},
+	}
+
+	for _, tc := range cases {
+		actual := canonicalRdata(tc.Type, tc.A) == canonicalRdata(tc.Type, tc.B)
+		if actual != tc.Equal {
+			t.Fatalf("type: %s\na: %q (%q)\nb: %q (%q)\nexpected equal: %t",
+				tc.Type, tc.A, canonicalRdata(tc.Type, tc.A), tc.B, canonicalRdata(tc.Type, tc.B), tc.Equal)
+		}
+	}
+}
+
+func TestRdataEquivalent(t *testing.T) {
+	a := []string{"127.0.0.1", "127.0.0.2"}
+	b := []string{"127.0.0.2", "127.0.0.1"}
+	if !rdataEquivalent("A", a, b) {
+		t.Fatalf("expected %v and %v to be equivalent", a, b)
+	}
+
+	c := []string{"127.0.0.1"}
+	if rdataEquivalent("A", a, c) {
+		t.Fatalf("expected %v and %v to differ", a, c)
+	}
+}
+
+func TestExpandTxtEntry(t *testing.T) {
+	cases := []struct {
+		Input, Output string
+	}{
+		{`"v=spf1 -all"`, "v=spf1 -all"},
+		{"v=spf1 -all", "v=spf1 -all"},
+		{`"`, `"`},
+		{"", ""},
+		{`"v=DKIM1; k=rsa; " "p=MIGf"`, "v=DKIM1; k=rsa; p=MIGf"},
+		{`"say \"hi\"" "C:\\temp"`, `say "hi"C:\temp`},
+		{`"caf\195\169"`, "café"},
+		{`"a" b`, `"a" b`},
+		{`"a`, `"a`},
+	}
+
+	for _, tc := range cases {
+		actual := expandTxtEntry(tc.Input)
+		if actual != tc.Output {
+			t.Fatalf("input: %q\noutput: %q", tc.Input, actual)
+		}
+	}
+}
+
+func TestRecordBlockRdata(t *testing.T) {
+	cases := []struct {
+		Type   string
+		Block  map[string]interface{}
+		Output string
+	}{
+		{"MX", map[string]interface{}{"preference": 10, "exchange": "mail.example.com."}, "10 mail.example.com."},
+		{"SRV", map[string]interface{}{"priority": 10, "weight": 60, "port": 5060, "target": "sip.example.com."}, "10 60 5060 sip.example.com."},
+		{"CAA", map[string]interface{}{"flags": 0, "tag": "issue", "value": "letsencrypt.org"}, `0 issue "letsencrypt.org"`},
+		{"NAPTR", map[string]interface{}{
+			"order": 100, "preference": 10, "flags": "S", "service": "SIP+D2U",
+			"regexp": "", "replacement": "_sip._udp.example.com.",
+		}, `100 10 "S" "SIP+D2U" "" _sip._udp.example.com.`},
+		{"CAA", map[string]interface{}{"flags": 0, "tag": "iodef", "value": "mailto:sécurité@example.com\t\"x\""}, `0 iodef "mailto:sécurité@example.com\009\"x\""`},
+		{"NAPTR", map[string]interface{}{
+			"order": 100, "preference": 10, "flags": "U", "service": "E2U+sip",
+			"regexp": `!^\+1(.*)$!sip:\1@example.com!`, "replacement": ".",
+		}, `100 10 "U" "E2U+sip" "!^\\+1(.*)$!sip:\\1@example.com!" .`},
+	}
+
+	for _, tc := range cases {
+		actual := recordBlockRdata(tc.Type, []interface{}{tc.Block})
+		if len(actual) != 1 || actual[0] != tc.Output {
+			t.Fatalf("input: %v\noutput: %v", tc.Block, actual)
+		}
+
+		blocks, err := flattenRecordBlocks(tc.Type, actual)
+		if err != nil {
+			t.Fatalf("input: %s\nerror: %s", actual[0], err)
+		}
+		if !reflect.DeepEqual(blocks[0], tc.Block) {
+			t.Fatalf("input: %s\noutput: %v", actual[0], blocks[0])
+		}
+	}
+}
+
+func TestFlattenRecordBlocks_invalid(t *testing.T) {
+	cases := []struct {
+		Type, Input string
+	}{
+		{"MX", "10"},
+		{"SRV", "10 60 sip.example.com."},
+		{"CAA", `0 issue`},
+		{"A", "127.0.0.1"},
+	}
+
+	for _, tc := range cases {
+		if _, err := flattenRecordBlocks(tc.Type, []string{tc.Input}); err == nil {
+			t.Fatalf("input: %s %s\nexpected an error", tc.Type, tc.Input)
+		}
+	}
+}
+
+func TestFlattenTxtEntry(t *testing.T) {
+	long := strings.Repeat("a", 300)
+
+	cases := []struct {
+		Input, Output string
+	}{
+		{"v=spf1 -all", `"v=spf1 -all"`},
+		{"", `""`},
+		{`say "hi"`, `"say \"hi\""`},
+		{`C:\temp`, `"C:\\temp"`},
+		{long, `"` + long[:255] + `" "` + long[255:] + `"`},
+		{strings.Repeat("a", 254) + "é", `"` + strings.Repeat("a", 254) + `" "é"`},
+	}
+
+	for _, tc := range cases {
+		actual := flattenTxtEntry(tc.Input)
+		if actual != tc.Output {
+			t.Fatalf("input: %q\noutput: %q", tc.Input, actual)
+		}
+
+		if back := expandTxtEntry(actual); back != tc.Input {
+			t.Fatalf("input: %q\nround trip: %q", tc.Input, back)
+		}
+	}
+}
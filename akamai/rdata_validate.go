@@ -0,0 +1,390 @@
+package akamai
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+// Record types supported by FastDNS that the SDK has no constant for.
+const (
+	rrTypeCert       = "CERT"
+	rrTypeDnskey     = "DNSKEY"
+	rrTypeDs         = "DS"
+	rrTypeHttps      = "HTTPS"
+	rrTypeNsec3      = "NSEC3"
+	rrTypeNsec3param = "NSEC3PARAM"
+	rrTypeRrsig      = "RRSIG"
+	rrTypeSvcb       = "SVCB"
+)
+
+// fastDNSRecordTypes lists the record types akamai_fastdns_record accepts.
+var fastDNSRecordTypes = []string{
+	akamai.RRTypeA,
+	akamai.RRTypeTxt,
+	akamai.RRTypeNs,
+	akamai.RRTypeCname,
+	akamai.RRTypeMx,
+	akamai.RRTypeNaptr,
+	akamai.RRTypePtr,
+	akamai.RRTypeSrv,
+	akamai.RRTypeSpf,
+	akamai.RRTypeAaaa,
+	akamai.RRTypeCaa,
+	akamai.RRTypeAfsdb,
+	rrTypeDnskey,
+	rrTypeDs,
+	akamai.RRTypeHinfo,
+	akamai.RRTypeLoc,
+	rrTypeNsec3,
+	rrTypeNsec3param,
+	akamai.RRTypeRp,
+	rrTypeRrsig,
+	akamai.RRTypeSshfp,
+	akamai.RRTypeTlsa,
+	rrTypeCert,
+	rrTypeHttps,
+	rrTypeSvcb,
+	akamai.RRTypeAkamaiCdn,
+	akamai.RRTypeAkamaiTlc,
+}
+
+// rdataValidators check a single rdata entry of a record type. Types
+// without an entry are left for Akamai to validate.
+var rdataValidators = map[string]func([]string) error{
+	akamai.RRTypeAfsdb:     validateAfsdbRdata,
+	rrTypeDnskey:           validateDnskeyRdata,
+	rrTypeDs:               validateDsRdata,
+	akamai.RRTypeHinfo:     validateHinfoRdata,
+	akamai.RRTypeLoc:       validateLocRdata,
+	rrTypeNsec3:            validateNsec3Rdata,
+	rrTypeNsec3param:       validateNsec3paramRdata,
+	akamai.RRTypeRp:        validateRpRdata,
+	rrTypeRrsig:            validateRrsigRdata,
+	akamai.RRTypeSshfp:     validateSshfpRdata,
+	akamai.RRTypeTlsa:      validateTlsaRdata,
+	rrTypeCert:             validateCertRdata,
+	rrTypeHttps:            validateSvcbRdata,
+	rrTypeSvcb:             validateSvcbRdata,
+	akamai.RRTypeAkamaiCdn: validateAkamaiCdnRdata,
+	akamai.RRTypeAkamaiTlc: validateAkamaiTlcRdata,
+}
+
+// validateRdata checks that s is valid presentation format rdata for the
+// record type.
+func validateRdata(typeStr, s string) error {
+	f, ok := rdataValidators[typeStr]
+	if !ok {
+		return nil
+	}
+
+	fields, err := rdataFields(s)
+	if err != nil {
+		return fmt.Errorf("invalid %s rdata %q: %s", typeStr, s, err)
+	}
+	if err := f(fields); err != nil {
+		return fmt.Errorf("invalid %s rdata %q: %s", typeStr, s, err)
+	}
+	return nil
+}
+
+func validateFieldCount(fields []string, min, max int) error {
+	if len(fields) < min || (max >= 0 && len(fields) > max) {
+		switch {
+		case min == max:
+			return fmt.Errorf("expected %d fields, got %d", min, len(fields))
+		case max < 0:
+			return fmt.Errorf("expected at least %d fields, got %d", min, len(fields))
+		default:
+			return fmt.Errorf("expected %d to %d fields, got %d", min, max, len(fields))
+		}
+	}
+	return nil
+}
+
+func validateUintField(name, s string, bits int) error {
+	if _, err := strconv.ParseUint(s, 10, bits); err != nil {
+		return fmt.Errorf("%s must be an unsigned %d bit integer, got %q", name, bits, s)
+	}
+	return nil
+}
+
+func validateDomainNameField(name, s string) error {
+	if s == "." {
+		return nil
+	}
+	n := strings.TrimSuffix(s, ".")
+	if n == "" || len(n) > 253 {
+		return fmt.Errorf("%s must be a domain name, got %q", name, s)
+	}
+	for _, l := range strings.Split(n, ".") {
+		if l == "" || len(l) > 63 {
+			return fmt.Errorf("%s must be a domain name, got %q", name, s)
+		}
+	}
+	return nil
+}
+
+func validateHexField(name, s string) error {
+	if s == "" || len(s)%2 != 0 {
+		return fmt.Errorf("%s must be an even number of hex digits", name)
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return fmt.Errorf("%s must be hex encoded: %s", name, err)
+	}
+	return nil
+}
+
+func validateBase64Field(name, s string) error {
+	if _, err := base64.StdEncoding.DecodeString(s); err != nil || s == "" {
+		return fmt.Errorf("%s must be base64 encoded", name)
+	}
+	return nil
+}
+
+var base32HexPattern = regexp.MustCompile(`^[0-9A-Va-v]+$`)
+
+func validateSaltField(s string) error {
+	if s == "-" {
+		return nil
+	}
+	return validateHexField("salt", s)
+}
+
+func validateAfsdbRdata(fields []string) error {
+	if err := validateFieldCount(fields, 2, 2); err != nil {
+		return err
+	}
+	if err := validateUintField("subtype", fields[0], 16); err != nil {
+		return err
+	}
+	return validateDomainNameField("hostname", fields[1])
+}
+
+func validateDnskeyRdata(fields []string) error {
+	if err := validateFieldCount(fields, 4, -1); err != nil {
+		return err
+	}
+	if err := validateUintField("flags", fields[0], 16); err != nil {
+		return err
+	}
+	if fields[1] != "3" {
+		return fmt.Errorf("protocol must be 3, got %q", fields[1])
+	}
+	if err := validateUintField("algorithm", fields[2], 8); err != nil {
+		return err
+	}
+	return validateBase64Field("public key", strings.Join(fields[3:], ""))
+}
+
+func validateDsRdata(fields []string) error {
+	if err := validateFieldCount(fields, 4, -1); err != nil {
+		return err
+	}
+	if err := validateUintField("key tag", fields[0], 16); err != nil {
+		return err
+	}
+	if err := validateUintField("algorithm", fields[1], 8); err != nil {
+		return err
+	}
+	if err := validateUintField("digest type", fields[2], 8); err != nil {
+		return err
+	}
+	return validateHexField("digest", strings.Join(fields[3:], ""))
+}
+
+func validateHinfoRdata(fields []string) error {
+	return validateFieldCount(fields, 2, 2)
+}
+
+// locPattern matches the RFC 1876 presentation format of a LOC record.
+var locPattern = regexp.MustCompile(`^` +
+	`\d{1,2}( \d{1,2}( \d{1,2}(\.\d{1,3})?)?)? [NSns] ` +
+	`\d{1,3}( \d{1,2}( \d{1,2}(\.\d{1,3})?)?)? [EWew] ` +
+	`-?\d+(\.\d{1,2})?m?` +
+	`( \d+(\.\d{1,2})?m?( \d+(\.\d{1,2})?m?( \d+(\.\d{1,2})?m?)?)?)?$`)
+
+func validateLocRdata(fields []string) error {
+	if !locPattern.MatchString(strings.Join(fields, " ")) {
+		return fmt.Errorf("expected \"d [m [s]] N|S d [m [s]] E|W alt[m] [size[m] [hp[m] [vp[m]]]]\"")
+	}
+	return nil
+}
+
+func validateNsec3Rdata(fields []string) error {
+	if err := validateFieldCount(fields, 5, -1); err != nil {
+		return err
+	}
+	if err := validateNsec3paramRdata(fields[:4]); err != nil {
+		return err
+	}
+	if !base32HexPattern.MatchString(fields[4]) {
+		return fmt.Errorf("next hashed owner name must be base32hex encoded, got %q", fields[4])
+	}
+	return nil
+}
+
+func validateNsec3paramRdata(fields []string) error {
+	if err := validateFieldCount(fields, 4, 4); err != nil {
+		return err
+	}
+	if err := validateUintField("hash algorithm", fields[0], 8); err != nil {
+		return err
+	}
+	if err := validateUintField("flags", fields[1], 8); err != nil {
+		return err
+	}
+	if err := validateUintField("iterations", fields[2], 16); err != nil {
+		return err
+	}
+	return validateSaltField(fields[3])
+}
+
+func validateRpRdata(fields []string) error {
+	if err := validateFieldCount(fields, 2, 2); err != nil {
+		return err
+	}
+	if err := validateDomainNameField("mailbox", fields[0]); err != nil {
+		return err
+	}
+	return validateDomainNameField("txt domain", fields[1])
+}
+
+var (
+	rrTypePattern    = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+	rrsigTimePattern = regexp.MustCompile(`^(\d{14}|\d{1,10})$`)
+)
+
+func validateRrsigRdata(fields []string) error {
+	if err := validateFieldCount(fields, 9, -1); err != nil {
+		return err
+	}
+	if !rrTypePattern.MatchString(fields[0]) {
+		return fmt.Errorf("type covered must be a record type, got %q", fields[0])
+	}
+	if err := validateUintField("algorithm", fields[1], 8); err != nil {
+		return err
+	}
+	if err := validateUintField("labels", fields[2], 8); err != nil {
+		return err
+	}
+	if err := validateUintField("original ttl", fields[3], 32); err != nil {
+		return err
+	}
+	for _, t := range fields[4:6] {
+		if !rrsigTimePattern.MatchString(t) {
+			return fmt.Errorf("signature times must be YYYYMMDDHHmmSS or seconds since the epoch, got %q", t)
+		}
+	}
+	if err := validateUintField("key tag", fields[6], 16); err != nil {
+		return err
+	}
+	if err := validateDomainNameField("signer name", fields[7]); err != nil {
+		return err
+	}
+	return validateBase64Field("signature", strings.Join(fields[8:], ""))
+}
+
+func validateSshfpRdata(fields []string) error {
+	if err := validateFieldCount(fields, 3, -1); err != nil {
+		return err
+	}
+	if err := validateUintField("algorithm", fields[0], 8); err != nil {
+		return err
+	}
+	if err := validateUintField("fingerprint type", fields[1], 8); err != nil {
+		return err
+	}
+	return validateHexField("fingerprint", strings.Join(fields[2:], ""))
+}
+
+func validateTlsaRdata(fields []string) error {
+	if err := validateFieldCount(fields, 4, -1); err != nil {
+		return err
+	}
+	if err := validateUintField("certificate usage", fields[0], 8); err != nil {
+		return err
+	}
+	if err := validateUintField("selector", fields[1], 8); err != nil {
+		return err
+	}
+	if err := validateUintField("matching type", fields[2], 8); err != nil {
+		return err
+	}
+	return validateHexField("certificate association data", strings.Join(fields[3:], ""))
+}
+
+// certTypeMnemonics are the RFC 4398 certificate type mnemonics.
+var certTypeMnemonics = map[string]bool{
+	"PKIX": true, "SPKI": true, "PGP": true, "IPKIX": true, "ISPKI": true,
+	"IPGP": true, "ACPKIX": true, "IACPKIX": true, "URI": true, "OID": true,
+}
+
+var algorithmMnemonicPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]*$`)
+
+func validateCertRdata(fields []string) error {
+	if err := validateFieldCount(fields, 4, -1); err != nil {
+		return err
+	}
+	if !certTypeMnemonics[strings.ToUpper(fields[0])] {
+		if err := validateUintField("certificate type", fields[0], 16); err != nil {
+			return err
+		}
+	}
+	if err := validateUintField("key tag", fields[1], 16); err != nil {
+		return err
+	}
+	if !algorithmMnemonicPattern.MatchString(fields[2]) {
+		if err := validateUintField("algorithm", fields[2], 8); err != nil {
+			return err
+		}
+	}
+	return validateBase64Field("certificate", strings.Join(fields[3:], ""))
+}
+
+var svcParamPattern = regexp.MustCompile(`^[a-z0-9-]+(=.*)?$`)
+
+func validateSvcbRdata(fields []string) error {
+	if err := validateFieldCount(fields, 2, -1); err != nil {
+		return err
+	}
+	if err := validateUintField("priority", fields[0], 16); err != nil {
+		return err
+	}
+	if err := validateDomainNameField("target", fields[1]); err != nil {
+		return err
+	}
+	if fields[0] == "0" && len(fields) > 2 {
+		return fmt.Errorf("records with priority 0 are in alias mode and take no parameters")
+	}
+	for _, p := range fields[2:] {
+		if !svcParamPattern.MatchString(p) {
+			return fmt.Errorf("parameters must be key or key=value, got %q", p)
+		}
+	}
+	return nil
+}
+
+func validateAkamaiCdnRdata(fields []string) error {
+	if err := validateFieldCount(fields, 1, 1); err != nil {
+		return err
+	}
+	return validateDomainNameField("edge hostname", fields[0])
+}
+
+func validateAkamaiTlcRdata(fields []string) error {
+	if err := validateFieldCount(fields, 2, 2); err != nil {
+		return err
+	}
+	switch strings.ToUpper(fields[0]) {
+	case "A", "AAAA", "DUAL":
+	default:
+		return fmt.Errorf("answer type must be A, AAAA or DUAL, got %q", fields[0])
+	}
+	return validateDomainNameField("dns name", fields[1])
+}
@@ -0,0 +1,58 @@
+package akamai
+
+import (
+	"testing"
+)
+
+func TestValidateRdata(t *testing.T) {
+	cases := []struct {
+		Type, Input string
+		Valid       bool
+	}{
+		{"A", "anything", true},
+		{"AFSDB", "1 afsdb.porchetta.io.", true},
+		{"AFSDB", "afsdb.porchetta.io.", false},
+		{"AFSDB", "70000 afsdb.porchetta.io.", false},
+		{"DNSKEY", "257 3 8 AwEAAafI2M0=", true},
+		{"DNSKEY", "257 2 8 AwEAAafI2M0=", false},
+		{"DNSKEY", "257 3 8 not*base64", false},
+		{"DS", "60485 5 1 2BB183AF5F22588179A53B0A98631FAD1A292118", true},
+		{"DS", "60485 5 1 2BB183AF5F2258817", false},
+		{"HINFO", `"INTEL-386" "Unix"`, true},
+		{"HINFO", `"INTEL-386"`, false},
+		{"LOC", "52 22 23.000 N 4 53 32.000 E -2.00m 0.00m 10000m 10m", true},
+		{"LOC", "52 N 4 E 10m", true},
+		{"LOC", "52 22 X 4 53 E 10m", false},
+		{"NSEC3", "1 0 10 AABB 2T7B4G4VSA5SMI47K61MV5BV1A22BOJR NS A", true},
+		{"NSEC3", "1 0 10 - 2T7B4G4VSA5SMI47K61MV5BV1A22BOJR", true},
+		{"NSEC3", "1 0 10 AABB not+base32hex", false},
+		{"NSEC3PARAM", "1 0 10 -", true},
+		{"NSEC3PARAM", "1 0 10 XYZ", false},
+		{"RP", "admin.porchetta.io. txt.porchetta.io.", true},
+		{"RP", "admin.porchetta.io.", false},
+		{"RRSIG", "A 8 3 300 20190801000000 20190701000000 12345 porchetta.io. AwEAAafI2M0=", true},
+		{"RRSIG", "A 8 3 300 2019-08-01 20190701000000 12345 porchetta.io. AwEAAafI2M0=", false},
+		{"SSHFP", "1 1 123456789abcdef67890123456789abcdef67890", true},
+		{"SSHFP", "1 1 xyz", false},
+		{"TLSA", "3 1 1 0B9FA5A59EED715C26C1020C711B4F6EC42D58B0015E14337A39DAD301C5AFC3", true},
+		{"TLSA", "3 1 256 0B9F", false},
+		{"CERT", "PGP 0 0 AwEAAafI2M0=", true},
+		{"CERT", "1 0 RSASHA256 AwEAAafI2M0=", true},
+		{"CERT", "PGP 70000 0 AwEAAafI2M0=", false},
+		{"HTTPS", "1 . alpn=h2,h3 port=443", true},
+		{"HTTPS", "0 svc.porchetta.io. alpn=h2", false},
+		{"SVCB", "0 svc.porchetta.io.", true},
+		{"SVCB", "svc.porchetta.io.", false},
+		{"AKAMAICDN", "www.porchetta.io.edgekey.net", true},
+		{"AKAMAICDN", "www.porchetta.io.edgekey.net other", false},
+		{"AKAMAITLC", "DUAL www.porchetta.io.", true},
+		{"AKAMAITLC", "CNAME www.porchetta.io.", false},
+	}
+
+	for _, tc := range cases {
+		err := validateRdata(tc.Type, tc.Input)
+		if (err == nil) != tc.Valid {
+			t.Fatalf("type: %s\ninput: %s\nerror: %v", tc.Type, tc.Input, err)
+		}
+	}
+}
@@ -5,13 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/hashicorp/terraform/config/hcl2shim"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/terraform-provider-akamai/akamai/internal/dnsname"
 )
 
 var akamaiNoRecordFound = errors.New("No matching record found.")
@@ -25,50 +30,196 @@ func resourceAkamaiFastDNSRecord() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceAkamaiFastDNSRecordV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceAkamaiFastDNSRecordStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
+		CustomizeDiff: resourceAkamaiFastDNSRecordCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
+			// Renaming a record replaces it. With create_before_destroy the
+			// new record set is created under its new name before the old
+			// one is removed, so the name never stops resolving.
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 				StateFunc: func(v interface{}) string {
 					value := strings.TrimSuffix(v.(string), ".")
 					return strings.ToLower(value)
 				},
+				// Relative and fully qualified spellings of the same name
+				// refer to the same record set, and replacing it would
+				// delete the record that was just created.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if d.Id() == "" {
+						return false
+					}
+					zone := d.Get("zone").(string)
+					return expandRecordName(old, zone) == expandRecordName(new, zone)
+				},
 			},
 
+			// rdata is left empty in state when one of the typed blocks is
+			// used instead.
 			"rdata": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"mx", "srv", "caa", "naptr"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
 
+			"mx": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"rdata", "srv", "caa", "naptr"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preference": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"exchange": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"srv": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"rdata", "mx", "caa", "naptr"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"target": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"caa": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"rdata", "mx", "srv", "naptr"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"flags": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 255),
+						},
+
+						"tag": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]{1,15}$`),
+								"must be 1 to 15 letters or digits"),
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"naptr": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"rdata", "mx", "srv", "caa"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"order": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"preference": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"flags": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9]*$`),
+								"must only contain letters and digits"),
+						},
+
+						"service": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"regexp": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"replacement": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      ".",
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
 			"ttl": {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
 
 			"type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					akamai.RRTypeA,
-					akamai.RRTypeTxt,
-					akamai.RRTypeNs,
-					akamai.RRTypeCname,
-					akamai.RRTypeMx,
-					akamai.RRTypeNaptr,
-					akamai.RRTypePtr,
-					akamai.RRTypeSrv,
-					akamai.RRTypeSpf,
-					akamai.RRTypeAaaa,
-					akamai.RRTypeCaa,
-				}, false),
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(fastDNSRecordTypes, false),
 			},
 
 			"zone": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
 
@@ -80,6 +231,51 @@ func resourceAkamaiFastDNSRecord() *schema.Resource {
 	}
 }
 
+func resourceAkamaiFastDNSRecordCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	typeStr := d.Get("type").(string)
+
+	if d.NewValueKnown("name") && d.NewValueKnown("zone") {
+		fqdn := expandRecordName(d.Get("name").(string), d.Get("zone").(string))
+		if err := dnsname.Validate(fqdn); err != nil {
+			return fmt.Errorf("invalid record name: %s", err)
+		}
+	}
+
+	for t, block := range recordTypeBlocks {
+		if t != typeStr && len(d.Get(block).([]interface{})) > 0 {
+			return fmt.Errorf("%s blocks can only be used with %s records, not %s", block, t, typeStr)
+		}
+	}
+
+	if d.NewValueKnown("rdata") {
+		for _, r := range d.Get("rdata").([]interface{}) {
+			if r.(string) == hcl2shim.UnknownVariableValue {
+				continue
+			}
+			if err := validateRdata(typeStr, r.(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Records need rdata or a typed block, also when an update removes
+	// them.
+	if !d.NewValueKnown("rdata") {
+		return nil
+	}
+	if block, ok := recordTypeBlocks[typeStr]; ok && len(d.Get(block).([]interface{})) > 0 {
+		return nil
+	}
+	if len(d.Get("rdata").([]interface{})) == 0 {
+		if block, ok := recordTypeBlocks[typeStr]; ok {
+			return fmt.Errorf("one of rdata or %s must be set for %s records", block, typeStr)
+		}
+		return fmt.Errorf("rdata must be set for %s records", typeStr)
+	}
+
+	return nil
+}
+
 func resourceAkamaiFastDNSRecordCreate(d *schema.ResourceData, m interface{}) error {
 	conn := m.(*AkamaiClient).client
 	zone := d.Get("zone").(string)
@@ -104,23 +300,24 @@ func resourceAkamaiFastDNSRecordCreate(d *schema.ResourceData, m interface{}) er
 	}
 
 	// add the resource records
-	if v, ok := d.GetOk("rdata"); ok {
-		recs := v.([]interface{})
-		rec.Rdata = expandResourceRecords(recs, d.Get("type").(string))
-	}
-
-	_, err = createFastDNSRecord(conn, rec)
-	if err != nil {
-		return err
+	rec.Rdata = expandRecordRdata(d)
+
+	// The apex NS record set is created along with the zone, so it can
+	// only be replaced.
+	if isApexNSRecord(en, *zoneRecord.Zone, rec.Type) {
+		log.Printf("[DEBUG] Replacing apex NS record set for zone: %s", zone)
+		_, _, err = conn.FastDNSv2.UpdateRecordSet(context.Background(), rec)
+		if err != nil {
+			return fmt.Errorf("[ERR]: Error replacing apex NS record set: %s", err)
+		}
+	} else {
+		_, err = createFastDNSRecord(conn, rec)
+		if err != nil {
+			return err
+		}
 	}
 
-	// generate an ID to use
-	vars := []string{
-		zone,
-		strings.ToLower(d.Get("name").(string)),
-		d.Get("type").(string),
-	}
-	d.SetId(strings.Join(vars, "_"))
+	d.SetId(recordId(zone, d.Get("name").(string), d.Get("type").(string)))
 	return nil
 }
 
@@ -154,14 +351,17 @@ func createFastDNSRecord(conn *akamai.Client, rec *akamai.RecordSetCreateRequest
 func resourceAkamaiFastDNSRecordRead(d *schema.ResourceData, m interface{}) error {
 	// If we don't have a zone ID we're doing an import. Parse it from the ID.
 	if _, ok := d.GetOk("zone"); !ok {
-		parts := parseRecordId(d.Id())
-		if parts[0] == "" || parts[1] == "" || parts[2] == "" {
-			return fmt.Errorf("Error importing akamai_fastdns_record. Please make sure the record ID is in the form ZONEID_RECORDNAME_TYPE.")
+		parts, err := parseRecordId(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error importing akamai_fastdns_record: %s", err)
 		}
 
 		d.Set("zone", parts[0])
 		d.Set("name", parts[1])
 		d.Set("type", parts[2])
+
+		// Imports may use the legacy underscore format.
+		d.SetId(recordId(parts[0], parts[1], parts[2]))
 	}
 
 	record, err := findRecord(d, m)
@@ -177,21 +377,46 @@ func resourceAkamaiFastDNSRecordRead(d *schema.ResourceData, m interface{}) erro
 		}
 	}
 
-	//rdata := cleanResourceRecords(record.Rdata, *record.Type)
-	//d.Set("rdata", rdata)
+	// Only replace the configured rdata when it really differs from what
+	// Akamai has, so presentation differences don't cause perpetual diffs.
+	recordType := d.Get("type").(string)
+	rdata := cleanResourceRecords(record.Rdata, recordType)
+
+	if block, ok := recordTypeBlocks[recordType]; ok && len(d.Get(block).([]interface{})) > 0 {
+		configured := recordBlockRdata(recordType, d.Get(block).([]interface{}))
+		if !rdataEquivalent(recordType, configured, rdata) {
+			log.Printf("[DEBUG] Akamai rdata for %s differs from %s blocks: %v", d.Id(), block, rdata)
+			blocks, err := flattenRecordBlocks(recordType, rdata)
+			if err != nil {
+				return fmt.Errorf("error parsing Akamai FastDNS record (%s) rdata: %s", d.Id(), err)
+			}
+			if err := d.Set(block, blocks); err != nil {
+				return fmt.Errorf("error setting %s: %s", block, err)
+			}
+		}
+		// Like the blocks, rdata is only kept in state when it's what the
+		// record is configured with.
+		d.Set("rdata", nil)
+	} else {
+		current := d.Get("rdata").([]interface{})
+		configured := make([]string, 0, len(current))
+		for _, r := range current {
+			configured = append(configured, r.(string))
+		}
+
+		if !rdataEquivalent(recordType, configured, rdata) {
+			log.Printf("[DEBUG] Akamai rdata for %s differs from state: %v", d.Id(), rdata)
+			d.Set("rdata", rdata)
+		}
+	}
 	d.Set("ttl", record.TTL)
 
 	return nil
 }
 
 func resourceAkamaiFastDNSRecordUpdate(d *schema.ResourceData, m interface{}) error {
-	// If the type or name of the record has changed
-	// we want to create a new record.
-	if d.HasChange("type") || d.HasChange("name") {
-		return resourceAkamaiFastDNSRecordCreate(d, m)
-	}
-
-	// Otherwise, continue to PUT a new record.
+	// The zone, name and type are ForceNew, so the record set being
+	// updated always exists under the same name.
 	conn := m.(*AkamaiClient).client
 	zone := d.Get("zone").(string)
 
@@ -215,10 +440,7 @@ func resourceAkamaiFastDNSRecordUpdate(d *schema.ResourceData, m interface{}) er
 	}
 
 	// add the resource records
-	if v, ok := d.GetOk("rdata"); ok {
-		recs := v.([]interface{})
-		rec.Rdata = expandResourceRecords(recs, d.Get("type").(string))
-	}
+	rec.Rdata = expandRecordRdata(d)
 
 	log.Printf("[DEBUG] Updating resource records for zone: %s, name : %s", zone, rec.Name)
 
@@ -231,13 +453,7 @@ func resourceAkamaiFastDNSRecordUpdate(d *schema.ResourceData, m interface{}) er
 		return fmt.Errorf("[ERR]: Could not update record set: HTTP %s", resp.Status)
 	}
 
-	// generate the ID
-	vars := []string{
-		zone,
-		strings.ToLower(d.Get("name").(string)),
-		d.Get("type").(string),
-	}
-	d.SetId(strings.Join(vars, "_"))
+	d.SetId(recordId(zone, d.Get("name").(string), d.Get("type").(string)))
 
 	return nil
 }
@@ -258,6 +474,13 @@ func resourceAkamaiFastDNSRecordDelete(d *schema.ResourceData, m interface{}) er
 	// build the record
 	en := expandRecordName(d.Get("name").(string), *zoneRecord.Zone)
 
+	// Akamai refuses to delete the apex NS record set, so leave it in
+	// place and only stop managing it.
+	if isApexNSRecord(en, *zoneRecord.Zone, d.Get("type").(string)) {
+		log.Printf("[WARN] Apex NS record set for zone %s cannot be deleted, removing from state only", zone)
+		return nil
+	}
+
 	input := &akamai.RecordSetOptions{
 		Zone: zone,
 		Name: en,
@@ -298,19 +521,16 @@ func deleteFastDNSRecord(conn *akamai.Client, rs *akamai.RecordSetOptions) (inte
 	return wait.WaitForState()
 }
 
-// Check if the current record name contains the zone suffix.
-// If it does not, add the zone name to form a fully qualified name.
+// expandRecordName returns the fully qualified name of a record. Names
+// that aren't already within the zone are taken as relative to it.
 func expandRecordName(name, zone string) string {
-	rn := strings.ToLower(strings.TrimSuffix(name, "."))
-	zone = strings.TrimSuffix(zone, ".")
-	if !strings.HasSuffix(rn, zone) {
-		if len(name) == 0 {
-			rn = zone
-		} else {
-			rn = strings.Join([]string{rn, zone}, ".")
-		}
-	}
-	return rn
+	return dnsname.Absolute(name, zone)
+}
+
+// isApexNSRecord reports whether the expanded record name and type refer to
+// the NS record set at the apex of the zone.
+func isApexNSRecord(name, zone, typeStr string) bool {
+	return typeStr == akamai.RRTypeNs && dnsname.Equal(name, zone)
 }
 
 // expandResourceRecords will take the records from the schema and
@@ -333,16 +553,62 @@ func expandResourceRecords(recs []interface{}, typeStr string) []string {
 	return records
 }
 
+// expandRecordRdata returns the rdata of the record, taken from the typed
+// block for its type when one is set and from rdata otherwise.
+func expandRecordRdata(d *schema.ResourceData) []string {
+	typeStr := d.Get("type").(string)
+	recs := d.Get("rdata").([]interface{})
+
+	if block, ok := recordTypeBlocks[typeStr]; ok {
+		if v, ok := d.GetOk(block); ok {
+			rdata := recordBlockRdata(typeStr, v.([]interface{}))
+			recs = make([]interface{}, 0, len(rdata))
+			for _, r := range rdata {
+				recs = append(recs, r)
+			}
+		}
+	}
+
+	return expandResourceRecords(recs, typeStr)
+}
+
+// recordId generates the ID we use to store a record in Terraform, in the
+// form ZONE/NAME/TYPE.
+func recordId(zone, name, typeStr string) string {
+	return strings.Join([]string{zone, strings.ToLower(name), typeStr}, "/")
+}
+
 // parseRecordId takes the ID which we use to store a record in Terraform and
-// returns back the zone, name, and record type
-func parseRecordId(id string) [3]string {
+// returns back the zone, name, and record type. IDs in the legacy
+// ZONE_NAME_TYPE format are accepted too, their name may contain
+// underscores.
+func parseRecordId(id string) ([3]string, error) {
 	var recZone, recName, recType string
-	parts := strings.Split(id, "_")
-	recZone, recName, recType = parts[0], parts[1], parts[2]
 
-	recName = strings.TrimSuffix(recName, ".")
+	if strings.Contains(id, "/") {
+		parts := strings.Split(id, "/")
+		if len(parts) != 3 {
+			return [3]string{}, fmt.Errorf("record ID %q is not in the form ZONE/NAME/TYPE", id)
+		}
+		recZone, recName, recType = parts[0], parts[1], parts[2]
+	} else {
+		first := strings.Index(id, "_")
+		last := strings.LastIndex(id, "_")
+		if first < 0 || first == last {
+			return [3]string{}, fmt.Errorf("record ID %q is not in the form ZONE/NAME/TYPE or ZONE_NAME_TYPE", id)
+		}
+		recZone, recName, recType = id[:first], id[first+1:last], id[last+1:]
+	}
+
+	recZone = dnsname.Normalize(recZone)
+	recName = dnsname.Normalize(recName)
+
+	// An empty name refers to the apex of the zone.
+	if recZone == "" || recType == "" {
+		return [3]string{}, fmt.Errorf("record ID %q is missing its zone or type", id)
+	}
 
-	return [3]string{recZone, recName, recType}
+	return [3]string{recZone, recName, recType}, nil
 }
 
 // findRecord takes a ResourceData struct for akamai_fastdns_record.
@@ -365,15 +631,18 @@ func findRecord(d *schema.ResourceData, meta interface{}) (*akamai.RecordSet, er
 	}
 
 	rs, resp, err := conn.FastDNSv2.GetRecordSet(context.Background(), rso)
+	if resp != nil && resp.StatusCode == 404 {
+		return nil, akamaiNoRecordFound
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode == 404 {
-		return nil, akamaiNoRecordFound
+	if rs.Name != nil && !dnsname.Equal(*rs.Name, en) {
+		return nil, fmt.Errorf("Akamai returned record set %s when looking up %s", *rs.Name, en)
 	}
 
-	return rs, err
+	return rs, nil
 }
 
 func cleanResourceRecords(recs []*string, typeStr string) []string {
@@ -391,14 +660,116 @@ func cleanResourceRecords(recs []*string, typeStr string) []string {
 	return strs
 }
 
+// txtChunkSize is the longest character-string a TXT record can hold.
+const txtChunkSize = 255
+
+var txtEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// flattenTxtEntry renders a TXT or SPF value as quoted character-strings.
+// Quotes and backslashes are escaped and values longer than 255 bytes are
+// split, without breaking up UTF-8 sequences.
 func flattenTxtEntry(s string) string {
-	return fmt.Sprintf(`"%s"`, s)
+	var chunks []string
+	for len(s) > txtChunkSize {
+		n := txtChunkSize
+		for n > 0 && !utf8.RuneStart(s[n]) {
+			n--
+		}
+		if n == 0 {
+			n = txtChunkSize
+		}
+		chunks = append(chunks, s[:n])
+		s = s[n:]
+	}
+	chunks = append(chunks, s)
+
+	for i, c := range chunks {
+		chunks[i] = quoteCharacterString(c)
+	}
+	return strings.Join(chunks, " ")
+}
+
+// quoteCharacterString renders s as a quoted RFC 1035 character-string.
+// Quotes and backslashes are escaped and control characters are written as
+// \DDD. Other bytes, including UTF-8 sequences, are kept as they are.
+func quoteCharacterString(s string) string {
+	s = txtEscaper.Replace(s)
+
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c < 0x20 || c == 0x7f {
+			fmt.Fprintf(&b, "\\%03d", c)
+			continue
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String()
 }
 
+// expandTxtEntry reassembles a value made up of one or more quoted
+// character-strings into a single string, undoing any escapes. Values
+// that aren't entirely quoted are returned unchanged.
 func expandTxtEntry(s string) string {
-	last := len(s) - 1
-	if last != 0 && s[0] == '"' && s[last] == '"' {
-		s = s[1:last]
+	var b strings.Builder
+	quoted := false
+
+	i := 0
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == '\t') {
+			i++
+		}
+		if i == len(s) {
+			break
+		}
+		if s[i] != '"' {
+			return s
+		}
+		i++
+
+		closed := false
+		for i < len(s) {
+			c := s[i]
+			if c == '"' {
+				closed = true
+				i++
+				break
+			}
+			if c == '\\' && i+1 < len(s) {
+				// \DDD is a decimal byte value, any other escaped
+				// character stands for itself.
+				if i+3 < len(s) && isDigits(s[i+1:i+4]) {
+					if v, _ := strconv.Atoi(s[i+1 : i+4]); v <= 255 {
+						b.WriteByte(byte(v))
+						i += 4
+						continue
+					}
+				}
+				b.WriteByte(s[i+1])
+				i += 2
+				continue
+			}
+			b.WriteByte(c)
+			i++
+		}
+		if !closed {
+			return s
+		}
+		quoted = true
+	}
+
+	if !quoted {
+		return s
+	}
+	return b.String()
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
 	}
-	return s
+	return true
 }
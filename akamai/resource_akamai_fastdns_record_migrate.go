@@ -0,0 +1,79 @@
+package akamai
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceAkamaiFastDNSRecordV0 is the original schema of
+// akamai_fastdns_record, from before record names were validated, typed
+// blocks were added and IDs moved to the ZONE/NAME/TYPE format. Only the
+// attribute types matter, so validation is left out.
+func resourceAkamaiFastDNSRecordV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"rdata": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceAkamaiFastDNSRecordStateUpgradeV0 moves IDs from the ZONE_NAME_TYPE
+// format, which is ambiguous for names with underscores, to ZONE/NAME/TYPE.
+func resourceAkamaiFastDNSRecordStateUpgradeV0(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	zone, _ := rawState["zone"].(string)
+	name, _ := rawState["name"].(string)
+	typeStr, _ := rawState["type"].(string)
+
+	// The attributes are authoritative, the ID is only parsed when they
+	// are missing. An empty name is the apex of the zone.
+	if zone == "" || typeStr == "" {
+		id, _ := rawState["id"].(string)
+		parts, err := parseRecordId(id)
+		if err != nil {
+			return nil, fmt.Errorf("error upgrading akamai_fastdns_record state: %s", err)
+		}
+		zone, name, typeStr = parts[0], parts[1], parts[2]
+	}
+
+	log.Printf("[DEBUG] Upgrading akamai_fastdns_record ID %v to the ZONE/NAME/TYPE format", rawState["id"])
+	rawState["id"] = recordId(zone, name, typeStr)
+
+	return rawState, nil
+}
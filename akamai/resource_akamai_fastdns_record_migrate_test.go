@@ -0,0 +1,76 @@
+package akamai
+
+import (
+	"testing"
+)
+
+func TestResourceAkamaiFastDNSRecordStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		State map[string]interface{}
+		ID    string
+	}{
+		{
+			State: map[string]interface{}{
+				"id":   "porchetta.io__dmarc_TXT",
+				"zone": "porchetta.io",
+				"name": "_dmarc",
+				"type": "TXT",
+			},
+			ID: "porchetta.io/_dmarc/TXT",
+		},
+		{
+			State: map[string]interface{}{
+				"id":   "porchetta.io_www_A",
+				"zone": "porchetta.io",
+				"name": "WWW",
+				"type": "A",
+			},
+			ID: "porchetta.io/www/A",
+		},
+		{
+			State: map[string]interface{}{
+				"id": "porchetta.io__sip._tcp_SRV",
+			},
+			ID: "porchetta.io/_sip._tcp/SRV",
+		},
+		{
+			State: map[string]interface{}{
+				"id":   "porchetta.io__A",
+				"zone": "porchetta.io",
+				"name": "",
+				"type": "A",
+			},
+			ID: "porchetta.io//A",
+		},
+	}
+
+	for _, tc := range cases {
+		input := tc.State["id"]
+		actual, err := resourceAkamaiFastDNSRecordStateUpgradeV0(tc.State, nil)
+		if err != nil {
+			t.Fatalf("input: %v\nerror: %s", input, err)
+		}
+		if actual["id"] != tc.ID {
+			t.Fatalf("input: %v\noutput: %v", input, actual["id"])
+		}
+	}
+
+	if _, err := resourceAkamaiFastDNSRecordStateUpgradeV0(map[string]interface{}{"id": "broken"}, nil); err == nil {
+		t.Fatalf("expected an error upgrading an unparseable ID")
+	}
+}
+
+func TestResourceAkamaiFastDNSRecordV0_schema(t *testing.T) {
+	block := resourceAkamaiFastDNSRecordV0().CoreConfigSchema()
+	attrs := block.Attributes
+
+	for _, k := range []string{"mx", "srv", "caa", "naptr"} {
+		if _, ok := block.BlockTypes[k]; ok {
+			t.Fatalf("%s was added after version 0", k)
+		}
+	}
+
+	if !attrs["rdata"].Required {
+		t.Fatalf("rdata was required in version 0")
+	}
+}
@@ -0,0 +1,229 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+func resourceAkamaiFastDNSRecordSets() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAkamaiFastDNSRecordSetsCreate,
+		Read:   resourceAkamaiFastDNSRecordSetsRead,
+		Update: resourceAkamaiFastDNSRecordSetsUpdate,
+		Delete: resourceAkamaiFastDNSRecordSetsDelete,
+
+		CustomizeDiff: resourceAkamaiFastDNSRecordSetsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Unlike akamai_fastdns_zone_records, only the record sets listed
+			// here are managed, others in the zone are left alone.
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     fastDNSRecordSetElem(),
+			},
+		},
+	}
+}
+
+func resourceAkamaiFastDNSRecordSetsCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("zone") || !d.NewValueKnown("record") {
+		return nil
+	}
+
+	return validateFastDNSRecordSets(d.Get("zone").(string), d.Get("record").(*schema.Set).List(), nil)
+}
+
+func resourceAkamaiFastDNSRecordSetsCreate(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+	desired := expandFastDNSRecordSets(d.Get("record").(*schema.Set).List(), zone)
+
+	log.Printf("[DEBUG] Creating %d record sets in Akamai FastDNS Zone (%s)", len(desired), zone)
+	err := applyFastDNSRecordSetChanges(conn, zone, d.Timeout(schema.TimeoutCreate), func() ([]*recordSetChange, error) {
+		current, err := listFastDNSRecordSetsByKey(conn, zone, desired)
+		if err != nil {
+			return nil, err
+		}
+
+		// Taking over existing record sets would delete them on destroy.
+		if err := existingFastDNSRecordSetsError(current); err != nil {
+			return nil, err
+		}
+
+		return diffFastDNSRecordSets(current, desired), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Akamai FastDNS Zone (%s) record sets: %s", zone, err)
+	}
+
+	d.SetId(resource.PrefixedUniqueId(zone + "-"))
+
+	return resourceAkamaiFastDNSRecordSetsRead(d, m)
+}
+
+func resourceAkamaiFastDNSRecordSetsRead(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+	configured := d.Get("record").(*schema.Set).List()
+
+	_, resp, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+	if resp != nil && resp.StatusCode == 404 {
+		log.Printf("[WARN] Akamai FastDNS Zone (%s) not found, removing record sets from state", zone)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", zone, err)
+	}
+
+	recordSets, err := listFastDNSRecordSetsByKey(conn, zone, expandFastDNSRecordSets(configured, zone))
+	if err != nil {
+		return err
+	}
+
+	// Record sets deleted outside of Terraform drop out of state and are
+	// planned to be added again.
+	records := flattenFastDNSRecordSets(recordSets, zone, configured)
+	if err := d.Set("record", records); err != nil {
+		return fmt.Errorf("error setting record: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAkamaiFastDNSRecordSetsUpdate(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+
+	if d.HasChange("record") {
+		o, n := d.GetChange("record")
+		previous := expandFastDNSRecordSets(o.(*schema.Set).List(), zone)
+		desired := expandFastDNSRecordSets(n.(*schema.Set).List(), zone)
+
+		log.Printf("[DEBUG] Updating record sets in Akamai FastDNS Zone (%s)", zone)
+		err := applyFastDNSRecordSetChanges(conn, zone, d.Timeout(schema.TimeoutUpdate), func() ([]*recordSetChange, error) {
+			// Record sets removed from the configuration are deleted,
+			// any others in the zone are left alone.
+			current, err := listFastDNSRecordSetsByKey(conn, zone, append(previous, desired...))
+			if err != nil {
+				return nil, err
+			}
+
+			// Like on create, added record sets must not exist yet.
+			managed := make(map[string]bool, len(previous))
+			for _, rs := range previous {
+				managed[recordSetKey(rs.Name, rs.Type)] = true
+			}
+			var added []*akamai.RecordSet
+			for _, rs := range current {
+				if !managed[recordSetKey(*rs.Name, *rs.Type)] {
+					added = append(added, rs)
+				}
+			}
+			if err := existingFastDNSRecordSetsError(added); err != nil {
+				return nil, err
+			}
+
+			return diffFastDNSRecordSets(current, desired), nil
+		})
+		if err != nil {
+			return fmt.Errorf("error updating Akamai FastDNS Zone (%s) record sets: %s", zone, err)
+		}
+	}
+
+	return resourceAkamaiFastDNSRecordSetsRead(d, m)
+}
+
+func resourceAkamaiFastDNSRecordSetsDelete(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+	managed := expandFastDNSRecordSets(d.Get("record").(*schema.Set).List(), zone)
+
+	log.Printf("[DEBUG] Deleting %d record sets from Akamai FastDNS Zone (%s)", len(managed), zone)
+	err := applyFastDNSRecordSetChanges(conn, zone, d.Timeout(schema.TimeoutDelete), func() ([]*recordSetChange, error) {
+		current, err := listFastDNSRecordSetsByKey(conn, zone, managed)
+		if err != nil {
+			return nil, err
+		}
+		return withoutApexNSDeletes(zone, diffFastDNSRecordSets(current, nil)), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Akamai FastDNS Zone (%s) record sets: %s", zone, err)
+	}
+
+	return nil
+}
+
+// listFastDNSRecordSetsByKey lists the record sets of the zone that have
+// the name and type of one of records.
+func listFastDNSRecordSetsByKey(conn *akamai.Client, zone string, records []*recordSetChange) ([]*akamai.RecordSet, error) {
+	keys := make(map[string]bool, len(records))
+	types := make(map[string]bool)
+	var typeList []string
+	for _, r := range records {
+		keys[recordSetKey(r.Name, r.Type)] = true
+		if !types[r.Type] {
+			types[r.Type] = true
+			typeList = append(typeList, r.Type)
+		}
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	recordSets, err := listFastDNSRecordSets(conn, zone, &akamai.ListZoneRecordSetOptions{
+		Types:    strings.Join(typeList, ","),
+		PageSize: fastDNSListPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var l []*akamai.RecordSet
+	for _, rs := range recordSets {
+		if rs == nil || rs.Name == nil || rs.Type == nil {
+			continue
+		}
+		if keys[recordSetKey(*rs.Name, *rs.Type)] {
+			l = append(l, rs)
+		}
+	}
+	return l, nil
+}
+
+// existingFastDNSRecordSetsError returns an error listing the record sets
+// if there are any.
+func existingFastDNSRecordSetsError(recordSets []*akamai.RecordSet) error {
+	if len(recordSets) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(recordSets))
+	for _, rs := range recordSets {
+		names = append(names, fmt.Sprintf("%s %s", *rs.Name, *rs.Type))
+	}
+	return fmt.Errorf("record sets already exist: %s", strings.Join(names, ", "))
+}
@@ -0,0 +1,143 @@
+package akamai
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+func TestAccAkamaiFastDNSRecordSets_basic(t *testing.T) {
+	resourceName := "akamai_fastdns_record_sets.test"
+	zoneName := fmt.Sprintf("testzone-recordsets-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSRecordSetsConfig(zoneName, 50, "127.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "50"),
+				),
+			},
+			{
+				Config: testAccFastDNSRecordSetsConfig(zoneName, 49, "127.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "49"),
+					testAccCheckFastDNSRecordGone(zoneName, "host49."+zoneName, "A"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAkamaiFastDNSRecordSets_existing(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-recordsets-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFastDNSRecordSetsConfig_existing(zoneName),
+				ExpectError: regexp.MustCompile("record sets already exist"),
+			},
+		},
+	})
+}
+
+func TestAccAkamaiFastDNSRecordSets_existingOnUpdate(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-recordsets-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSRecordSetsConfig(zoneName, 2, "127.0.0.1"),
+			},
+			{
+				// Adding a record set that already exists must not adopt it.
+				PreConfig: func() {
+					conn := testAccProvider.Meta().(*AkamaiClient).client
+					rec := &akamai.RecordSetCreateRequest{
+						Zone:  zoneName,
+						Name:  "host2." + zoneName,
+						Type:  "A",
+						TTL:   300,
+						Rdata: []string{"127.0.2.2"},
+					}
+					if _, err := createFastDNSRecord(conn, rec); err != nil {
+						t.Fatalf("error creating out of band record: %s", err)
+					}
+				},
+				Config:      testAccFastDNSRecordSetsConfig(zoneName, 3, "127.0.0.1"),
+				ExpectError: regexp.MustCompile("record sets already exist: host2"),
+			},
+		},
+	})
+}
+
+func testAccFastDNSRecordSetsConfig(zone string, count int, ip string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+  force_destroy = true
+}
+
+resource "akamai_fastdns_record_sets" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+
+  dynamic "record" {
+    for_each = range(%d)
+
+    content {
+      name = "host${record.value}"
+      type = "A"
+      ttl = 300
+      rdata = [record.value == 0 ? "%s" : "127.0.1.${record.value}"]
+    }
+  }
+}
+`, zone, count, ip)
+}
+
+func testAccFastDNSRecordSetsConfig_existing(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_record" "www" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+  name = "www"
+  type = "A"
+  ttl = 300
+  rdata = ["127.0.0.1"]
+}
+
+resource "akamai_fastdns_record_sets" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+
+  record {
+    name = "www"
+    type = "A"
+    ttl = 300
+    rdata = ["127.0.0.2"]
+  }
+
+  depends_on = ["akamai_fastdns_record.www"]
+}
+`, zone)
+}
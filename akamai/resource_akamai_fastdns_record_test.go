@@ -3,9 +3,11 @@ package akamai
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -23,6 +25,10 @@ func TestExpandRecordName(t *testing.T) {
 		{"porchetta.io", "porchetta.io"},
 		{"test.porchetta.io", "test.porchetta.io"},
 		{"test.porchetta.io.", "test.porchetta.io"},
+		{"notporchetta.io", "notporchetta.io.porchetta.io"},
+		{"_dmarc", "_dmarc.porchetta.io"},
+		{"", "porchetta.io"},
+		{"bücher", "xn--bcher-kva.porchetta.io"},
 	}
 	zoneName := "porchetta.io"
 	for _, tc := range cases {
@@ -35,6 +41,115 @@ func TestExpandRecordName(t *testing.T) {
 
 }
 
+func TestIsApexNSRecord(t *testing.T) {
+	cases := []struct {
+		Name, Type string
+		Apex       bool
+	}{
+		{"porchetta.io", "NS", true},
+		{"porchetta.io", "A", false},
+		{"sub.porchetta.io", "NS", false},
+	}
+	for _, tc := range cases {
+		actual := isApexNSRecord(tc.Name, "porchetta.io.", tc.Type)
+		if actual != tc.Apex {
+			t.Fatalf("name: %s type: %s\noutput: %t", tc.Name, tc.Type, actual)
+		}
+	}
+}
+
+func TestParseRecordId(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Output [3]string
+		Error  bool
+	}{
+		{"porchetta.io/www/A", [3]string{"porchetta.io", "www", "A"}, false},
+		{"porchetta.io/_dmarc/TXT", [3]string{"porchetta.io", "_dmarc", "TXT"}, false},
+		{"porchetta.io/_sip._tcp/SRV", [3]string{"porchetta.io", "_sip._tcp", "SRV"}, false},
+		{"porchetta.io//A", [3]string{"porchetta.io", "", "A"}, false},
+		{"porchetta.io_www_A", [3]string{"porchetta.io", "www", "A"}, false},
+		{"porchetta.io__acme-challenge_TXT", [3]string{"porchetta.io", "_acme-challenge", "TXT"}, false},
+		{"porchetta.io__sip._tcp_SRV", [3]string{"porchetta.io", "_sip._tcp", "SRV"}, false},
+		{"porchetta.io_www._A", [3]string{"porchetta.io", "www", "A"}, false},
+		{"porchetta.io/www", [3]string{}, true},
+		{"porchetta.io/www/A/extra", [3]string{}, true},
+		{"porchetta.io_A", [3]string{}, true},
+		{"porchetta.io", [3]string{}, true},
+		{"", [3]string{}, true},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseRecordId(tc.Input)
+		if (err != nil) != tc.Error {
+			t.Fatalf("input: %s\nerror: %v", tc.Input, err)
+		}
+		if actual != tc.Output {
+			t.Fatalf("input: %s\noutput: %v", tc.Input, actual)
+		}
+	}
+}
+
+func TestResourceAkamaiFastDNSRecordDiff_rdataRemoved(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "porchetta.io/www/A",
+		Attributes: map[string]string{
+			"id":      "porchetta.io/www/A",
+			"zone":    "porchetta.io",
+			"name":    "www",
+			"type":    "A",
+			"ttl":     "300",
+			"rdata.#": "1",
+			"rdata.0": "127.0.0.1",
+		},
+	}
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"zone": "porchetta.io",
+		"name": "www",
+		"type": "A",
+		"ttl":  300,
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	_, err = resourceAkamaiFastDNSRecord().Diff(state, terraform.NewResourceConfig(raw), nil)
+	if err == nil || !strings.Contains(err.Error(), "rdata must be set") {
+		t.Fatalf("expected error about missing rdata, got: %v", err)
+	}
+}
+
+func TestAccFastDNSRecord_importUnderscore(t *testing.T) {
+	resourceName := "akamai_fastdns_record.default"
+	zoneName := fmt.Sprintf("testzone-import-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSRecordConfig_typed(zoneName, "TXT", "_dmarc", "v=DMARC1; p=none"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", zoneName+"/_dmarc/TXT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     zoneName + "__dmarc_TXT",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAkamaiFastDNSRecord_basic(t *testing.T) {
 	var record akamai.RecordSet
 
@@ -107,6 +222,40 @@ func TestAccFastDNSRecord_modify(t *testing.T) {
 	})
 }
 
+func TestAccFastDNSRecord_rename(t *testing.T) {
+	var before, after akamai.RecordSet
+
+	resourceName := "akamai_fastdns_record.default"
+	zoneName := fmt.Sprintf("testzone-rename-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSRecordConfig_rename(zoneName, "www"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastDNSRecordExists(resourceName, &before),
+				),
+			},
+			{
+				Config: testAccFastDNSRecordConfig_rename(zoneName, "www2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastDNSRecordExists(resourceName, &after),
+					testAccCheckFastDNSRecordGone(zoneName, "www."+zoneName, "A"),
+				),
+			},
+			{
+				// A fully qualified spelling of the same name is not a rename.
+				Config:             testAccFastDNSRecordConfig_rename(zoneName, "www2."+zoneName+"."),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
 func TestAccFastDNSRecord_cname(t *testing.T) {
 	var record akamai.RecordSet
 
@@ -149,6 +298,160 @@ func TestAccFastDNSRecord_txt(t *testing.T) {
 	})
 }
 
+func TestAccFastDNSRecord_mxBlock(t *testing.T) {
+	var record akamai.RecordSet
+
+	resourceName := "akamai_fastdns_record.default"
+	zoneName := fmt.Sprintf("testzone-mx-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSRecordConfig_mxBlock(zoneName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastDNSRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "mx.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rdata.#", "0"),
+				),
+			},
+			{
+				Config: testAccFastDNSRecordConfig_mxBlock(zoneName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastDNSRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "mx.0.preference", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastDNSRecord_blockTypeMismatch(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-mismatch-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFastDNSRecordConfig_blockTypeMismatch(zoneName),
+				ExpectError: regexp.MustCompile("mx blocks can only be used with MX records"),
+			},
+		},
+	})
+}
+
+func TestAccFastDNSRecord_sshfp(t *testing.T) {
+	var record akamai.RecordSet
+
+	resourceName := "akamai_fastdns_record.default"
+	zoneName := fmt.Sprintf("testzone-sshfp-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSRecordConfig_typed(zoneName, "SSHFP", "host", "1 1 123456789ABCDEF67890123456789ABCDEF67890"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastDNSRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "type", "SSHFP"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastDNSRecord_invalidRdata(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-invalid-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFastDNSRecordConfig_typed(zoneName, "TLSA", "_443._tcp", "3 1 1 not-hex"),
+				ExpectError: regexp.MustCompile("invalid TLSA rdata"),
+			},
+		},
+	})
+}
+
+func TestAccFastDNSRecord_longTxt(t *testing.T) {
+	var record akamai.RecordSet
+
+	resourceName := "akamai_fastdns_record.default"
+	zoneName := fmt.Sprintf("testzone-longtxt-%s.terraformtest.com", acctest.RandString(8))
+	value := "v=DKIM1; k=rsa; p=" + acctest.RandString(400)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSRecordConfig_typed(zoneName, "TXT", "selector._domainkey", value),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastDNSRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "rdata.0", value),
+				),
+			},
+		},
+	})
+}
+
+func TestAccFastDNSRecord_rdataDrift(t *testing.T) {
+	var record akamai.RecordSet
+
+	resourceName := "akamai_fastdns_record.default"
+	zoneName := fmt.Sprintf("testzone-drift-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSRecordConfig_basic(zoneName, "127.0.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastDNSRecordExists(resourceName, &record),
+					testAccCheckFastDNSRecordChangeRdata(&record, zoneName, "127.0.0.99"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccFastDNSRecordConfig_basic(zoneName, "127.0.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFastDNSRecordExists(resourceName, &record),
+					resource.TestCheckResourceAttr(resourceName, "rdata.0", "127.0.0.10"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckFastDNSRecordChangeRdata changes the record outside of
+// Terraform, as if it was edited in Control Center.
+func testAccCheckFastDNSRecordChangeRdata(record *akamai.RecordSet, zone, rdata string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AkamaiClient).client
+
+		rec := &akamai.RecordSetCreateRequest{
+			Zone:  zone,
+			Name:  *record.Name,
+			Type:  *record.Type,
+			TTL:   *record.TTL,
+			Rdata: []string{rdata},
+		}
+
+		_, _, err := conn.FastDNSv2.UpdateRecordSet(context.Background(), rec)
+		return err
+	}
+}
+
 func testAccCheckFastDNSRecordDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AkamaiClient).client
 
@@ -157,7 +460,10 @@ func testAccCheckFastDNSRecordDestroy(s *terraform.State) error {
 			continue
 		}
 
-		parts := parseRecordId(rs.Primary.ID)
+		parts, err := parseRecordId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 		zone, name, rType := parts[0], parts[1], parts[2]
 
 		en := expandRecordName(name, "akamaiexample.com")
@@ -189,6 +495,28 @@ func testAccCheckFastDNSRecordDestroy(s *terraform.State) error {
 
 }
 
+func testAccCheckFastDNSRecordGone(zone, name, rType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AkamaiClient).client
+
+		ars := &akamai.RecordSetOptions{
+			Zone: zone,
+			Name: name,
+			Type: rType,
+		}
+
+		_, resp, err := conn.FastDNSv2.GetRecordSet(context.Background(), ars)
+		if resp != nil && resp.StatusCode == 404 {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("could not look up record: %v", err)
+		}
+
+		return fmt.Errorf("Record %s %s still exists in zone %s", name, rType, zone)
+	}
+}
+
 func testAccCheckFastDNSRecordExists(n string, record *akamai.RecordSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AkamaiClient).client
@@ -201,7 +529,10 @@ func testAccCheckFastDNSRecordExists(n string, record *akamai.RecordSet) resourc
 			return fmt.Errorf("No ID is set")
 		}
 
-		parts := parseRecordId(rs.Primary.ID)
+		parts, err := parseRecordId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
 		zone, name, rType := parts[0], parts[1], parts[2]
 		en := expandRecordName(name, zone)
 
@@ -296,3 +627,92 @@ resource "akamai_fastdns_record" "default" {
 }
 `, zone, record, record)
 }
+
+func testAccFastDNSRecordConfig_mxBlock(zone string, preference int) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "main" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_record" "default" {
+  zone = "${akamai_fastdns_zone.main.zone}"
+  type = "MX"
+  name = "mail"
+  ttl = "30"
+
+  mx {
+    preference = %d
+    exchange = "mx1.%s."
+  }
+
+  mx {
+    preference = 30
+    exchange = "mx2.%s."
+  }
+}
+`, zone, preference, zone, zone)
+}
+
+func testAccFastDNSRecordConfig_blockTypeMismatch(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "main" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_record" "default" {
+  zone = "${akamai_fastdns_zone.main.zone}"
+  type = "A"
+  name = "www"
+  ttl = "30"
+
+  mx {
+    preference = 10
+    exchange = "mx1.%s."
+  }
+}
+`, zone, zone)
+}
+
+func testAccFastDNSRecordConfig_typed(zone, typeStr, name, rdata string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "main" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_record" "default" {
+  zone = "${akamai_fastdns_zone.main.zone}"
+  type = "%s"
+  name = "%s"
+  ttl = "30"
+  rdata = ["%s"]
+}
+`, zone, typeStr, name, rdata)
+}
+
+func testAccFastDNSRecordConfig_rename(zone, name string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "main" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_record" "default" {
+  zone = "${akamai_fastdns_zone.main.zone}"
+  name = "%s"
+  type = "A"
+  ttl = "30"
+  rdata = ["127.0.0.10"]
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, zone, name)
+}
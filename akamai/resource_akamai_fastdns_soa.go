@@ -0,0 +1,199 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+// Akamai's defaults for a newly created zone's SOA record. These are
+// restored when an akamai_fastdns_soa resource is destroyed.
+const (
+	defaultSOATTL     = 86400
+	defaultSOARefresh = 3600
+	defaultSOARetry   = 600
+	defaultSOAExpire  = 604800
+	defaultSOAMinimum = 300
+)
+
+func resourceAkamaiFastDNSSOA() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAkamaiFastDNSSOACreate,
+		Read:   resourceAkamaiFastDNSSOARead,
+		Update: resourceAkamaiFastDNSSOAUpdate,
+		Delete: resourceAkamaiFastDNSSOADelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"contact": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return soaContactToRname(old) == soaContactToRname(new)
+				},
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"refresh": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"expire": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"minimum": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"primary_name_server": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Every zone always has exactly one SOA record, so creating this resource
+// only takes over management of the existing record.
+func resourceAkamaiFastDNSSOACreate(d *schema.ResourceData, m interface{}) error {
+	zone := d.Get("zone").(string)
+	d.SetId(zone)
+
+	return resourceAkamaiFastDNSSOAUpdate(d, m)
+}
+
+func resourceAkamaiFastDNSSOARead(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+
+	rso := &akamai.RecordSetOptions{
+		Zone: d.Id(),
+		Name: d.Id(),
+		Type: "SOA",
+	}
+
+	log.Printf("[DEBUG] Getting Akamai FastDNS Zone (%s) SOA record", d.Id())
+	rs, resp, err := conn.FastDNSv2.GetRecordSet(context.Background(), rso)
+	if resp != nil && resp.StatusCode == 404 {
+		log.Printf("[WARN] Akamai FastDNS Zone (%s) SOA record not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS Zone (%s) SOA record: %s", d.Id(), err)
+	}
+
+	soa, err := parseSOARecordSet(rs)
+	if err != nil {
+		return err
+	}
+
+	d.Set("zone", d.Id())
+	d.Set("contact", soa.RName)
+	d.Set("ttl", soa.TTL)
+	d.Set("refresh", soa.Refresh)
+	d.Set("retry", soa.Retry)
+	d.Set("expire", soa.Expire)
+	d.Set("minimum", soa.Minimum)
+	d.Set("primary_name_server", soa.MName)
+	d.Set("serial", soa.Serial)
+
+	return nil
+}
+
+func resourceAkamaiFastDNSSOAUpdate(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+
+	l := []interface{}{
+		map[string]interface{}{
+			"contact": d.Get("contact").(string),
+			"ttl":     d.Get("ttl").(int),
+			"refresh": d.Get("refresh").(int),
+			"retry":   d.Get("retry").(int),
+			"expire":  d.Get("expire").(int),
+			"minimum": d.Get("minimum").(int),
+		},
+	}
+
+	err := updateFastDNSZoneSOA(conn, d.Id(), l)
+	if err != nil {
+		return err
+	}
+
+	return resourceAkamaiFastDNSSOARead(d, m)
+}
+
+// The SOA record cannot be removed from a zone, so deleting the resource
+// resets it to the values Akamai uses for a new zone.
+func resourceAkamaiFastDNSSOADelete(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+
+	// Nothing to reset if the zone itself is already gone.
+	_, resp, err := conn.FastDNSv2.GetZone(context.Background(), d.Id())
+	if resp != nil && resp.StatusCode == 404 {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", d.Id(), err)
+	}
+
+	l := []interface{}{
+		map[string]interface{}{
+			"contact": fmt.Sprintf("hostmaster.%s", d.Id()),
+			"ttl":     defaultSOATTL,
+			"refresh": defaultSOARefresh,
+			"retry":   defaultSOARetry,
+			"expire":  defaultSOAExpire,
+			"minimum": defaultSOAMinimum,
+		},
+	}
+
+	log.Printf("[DEBUG] Resetting Akamai FastDNS Zone (%s) SOA record to defaults", d.Id())
+	err = updateFastDNSZoneSOA(conn, d.Id(), l)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+func TestAccAkamaiFastDNSSOA_basic(t *testing.T) {
+	resourceName := "akamai_fastdns_soa.test"
+	zoneName := fmt.Sprintf("testzone-soa-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSSOAConfig(zoneName, 1800),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastDNSSOARefresh(resourceName, 1800),
+					resource.TestCheckResourceAttr(resourceName, "contact", fmt.Sprintf("hostmaster.%s.", zoneName)),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_name_server"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+				),
+			},
+			{
+				Config: testAccFastDNSSOAConfig(zoneName, 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastDNSSOARefresh(resourceName, 7200),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFastDNSSOARefresh(n string, refresh int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AkamaiClient).client
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		rso := &akamai.RecordSetOptions{
+			Zone: rs.Primary.ID,
+			Name: rs.Primary.ID,
+			Type: "SOA",
+		}
+
+		r, _, err := conn.FastDNSv2.GetRecordSet(context.Background(), rso)
+		if err != nil {
+			return fmt.Errorf("SOA record err: %v", err)
+		}
+
+		soa, err := parseSOARecordSet(r)
+		if err != nil {
+			return err
+		}
+
+		if soa.Refresh != refresh {
+			return fmt.Errorf("SOA refresh is %d; want %d", soa.Refresh, refresh)
+		}
+
+		if rs.Primary.Attributes["refresh"] != strconv.Itoa(refresh) {
+			return fmt.Errorf("SOA refresh in state is %s; want %d", rs.Primary.Attributes["refresh"], refresh)
+		}
+
+		return nil
+	}
+}
+
+func testAccFastDNSSOAConfig(zone string, refresh int) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "main" {
+  zone = "%[1]s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_soa" "test" {
+  zone = "${akamai_fastdns_zone.main.zone}"
+  contact = "hostmaster@%[1]s"
+  refresh = %[2]d
+}
+`, zone, refresh)
+}
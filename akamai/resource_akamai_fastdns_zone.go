@@ -4,10 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/trussworks/akamai-sdk-go/akamai"
 )
 
@@ -18,6 +22,12 @@ func resourceAkamaiFastDNSZone() *schema.Resource {
 		Update: resourceAkamaiFastDNSZoneUpdate,
 		Delete: resourceAkamaiFastDNSZoneDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAkamaiFastDNSZoneCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(240 * time.Second),
 			Update: schema.DefaultTimeout(240 * time.Second),
@@ -30,6 +40,17 @@ func resourceAkamaiFastDNSZone() *schema.Resource {
 				Required: true,
 			},
 
+			// The group only matters when the zone is created and Akamai
+			// doesn't return it, so imported zones don't have one in state.
+			"group_id": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != "" && (old == "" || old == "0")
+				},
+			},
+
 			"zone": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -59,10 +80,115 @@ func resourceAkamaiFastDNSZone() *schema.Resource {
 				Optional: true,
 				Default:  "Managed by Terraform",
 			},
+
+			"wait_for_activation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"activation_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"soa": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"contact": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+								return soaContactToRname(old) == soaContactToRname(new)
+							},
+						},
+
+						"ttl": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"refresh": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"retry": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"expire": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"minimum": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"soa_serial": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"name_servers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
+// resourceAkamaiFastDNSZoneCustomizeDiff plans an update for zones that were
+// never bootstrapped or never became active, so the next apply can finish
+// the job.
+func resourceAkamaiFastDNSZoneCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	state := d.Get("activation_state").(string)
+	if state == "NEW" || (state == "PENDING" && d.Get("wait_for_activation").(bool)) {
+		return d.SetNewComputed("activation_state")
+	}
+	return nil
+}
+
 func resourceAkamaiFastDNSZoneCreate(d *schema.ResourceData, m interface{}) error {
 	conn := m.(*AkamaiClient).client
 
@@ -76,45 +202,135 @@ func resourceAkamaiFastDNSZoneCreate(d *schema.ResourceData, m interface{}) erro
 	cid := d.Get("contract_id").(string)
 	log.Printf("[DEBUG] Creating Akamai FastDNS Hosted Zone: %s", input.Zone)
 
-	output, _, err := conn.FastDNSv2.CreateZone(context.Background(), cid, input)
-	if err != nil {
-		return fmt.Errorf("error creating Akamai FastDNS Hosted Zone: %s", err)
+	var output *akamai.Zone
+	var resp *akamai.Response
+	var err error
+	if gid, ok := d.GetOk("group_id"); ok {
+		opt := &zoneCreateOptions{
+			ContractID: cid,
+			GroupID:    gid.(int),
+		}
+		output, resp, err = createZone(context.Background(), conn, opt, input)
+	} else {
+		output, resp, err = conn.FastDNSv2.CreateZone(context.Background(), cid, input)
 	}
+	if resp != nil && resp.StatusCode == 409 {
+		// A previous apply may have created the zone and then failed before
+		// it was bootstrapped. If so, adopt the zone and resume.
+		if !adoptableFastDNSZone(conn, input.Zone, cid) {
+			return fmt.Errorf("error creating Akamai FastDNS Hosted Zone: %s", err)
+		}
 
-	log.Printf("[DEBUG] Akamai FastDNS Hosted Zone Created: %v", *output.Zone)
-	log.Printf("[DEBUG] Setting SOA and NS records. Akamai does not apply these by default.")
+		log.Printf("[DEBUG] Adopting Akamai FastDNS Hosted Zone that was never bootstrapped: %s", input.Zone)
+	} else if err != nil {
+		return fmt.Errorf("error creating Akamai FastDNS Hosted Zone: %s", err)
+	} else {
+		log.Printf("[DEBUG] Akamai FastDNS Hosted Zone Created: %v", *output.Zone)
+	}
 
-	cli := &akamai.ChangeListOptions{
-		Zone: input.Zone,
+	// The zone exists from here on, so make sure Terraform knows about it
+	// even if bootstrapping fails. Only the configuration and the
+	// activation state reached so far are saved, which lets Read report the
+	// zone as NEW or PENDING and the next apply resume where this left off.
+	d.SetId(input.Zone)
+	d.Partial(true)
+	for _, k := range []string{"zone", "contract_id", "group_id", "type", "comment", "sign_and_serve", "wait_for_activation", "deletion_protection", "force_destroy"} {
+		d.SetPartial(k)
 	}
+	d.Set("activation_state", "NEW")
+	d.SetPartial("activation_state")
 
-	clo, _, err := conn.FastDNSv2.CreateChangeList(context.Background(), cli)
+	log.Printf("[DEBUG] Setting SOA and NS records. Akamai does not apply these by default.")
+	err = bootstrapFastDNSZone(conn, d.Id(), d.Get("soa").([]interface{}), d.Timeout(schema.TimeoutCreate))
 	if err != nil {
-		return fmt.Errorf("error creating Akamai FastDNS change list: %s", err)
+		return fmt.Errorf("Akamai FastDNS Hosted Zone (%s) created but bootstrap failed, re-run apply to resume: %s", d.Id(), err)
 	}
+	d.Set("activation_state", "PENDING")
 
-	if clo.Stale {
-		return fmt.Errorf("Akamai changelist was stale. Must be current to apply.")
+	// Records created against a zone that is still NEW or PENDING are
+	// frequently rejected, so wait for Akamai to activate the zone.
+	if d.Get("wait_for_activation").(bool) {
+		log.Printf("[DEBUG] Waiting for Akamai FastDNS Hosted Zone (%s) to become active", d.Id())
+		_, err = waitForFastDNSZoneActivation(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return fmt.Errorf("Akamai FastDNS Hosted Zone (%s) created but did not become active, re-run apply to resume: %s", d.Id(), err)
+		}
 	}
 
-	_, err = conn.FastDNSv2.SubmitChangeList(context.Background(), input.Zone)
-	if err != nil {
-		return fmt.Errorf("error submitting Akamai FastDNS change list: %s", err)
+	d.Partial(false)
+
+	return resourceAkamaiFastDNSZoneRead(d, m)
+}
+
+// adoptableFastDNSZone reports whether an existing zone was created in the
+// given contract but never had its initial change list submitted.
+func adoptableFastDNSZone(conn *akamai.Client, zone, cid string) bool {
+	z, _, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+	if err != nil || z == nil || z.ActivationState == nil || z.ContractID == nil {
+		return false
 	}
 
-	d.SetId(*output.Zone)
+	return *z.ActivationState == "NEW" && *z.ContractID == cid
+}
 
-	return resourceAkamaiFastDNSZoneRead(d, m)
+// bootstrapFastDNSZone creates and submits the zone's initial change list,
+// which is what gives a new zone its SOA and NS records. Stale change lists
+// are replaced, pending ones are waited for.
+func bootstrapFastDNSZone(conn *akamai.Client, zone string, soa []interface{}, timeout time.Duration) error {
+	defer lockFastDNSChangeList(zone)()
+
+	wait := resource.StateChangeConf{
+		Pending:    []string{"stale", "exists"},
+		Target:     []string{"submitted"},
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			created, err := createFastDNSChangeList(conn, zone)
+			if err != nil {
+				return 42, "failure", err
+			}
+			if !created {
+				return 42, "exists", nil
+			}
+
+			if len(soa) > 0 {
+				log.Printf("[DEBUG] Applying SOA parameters to Akamai FastDNS change list: %s", zone)
+				err = applyFastDNSZoneChangeListSOA(conn, zone, soa)
+				if err != nil {
+					conn.FastDNSv2.DeleteChangeList(context.Background(), zone)
+					return 42, "failure", err
+				}
+			}
+
+			resp, err := conn.FastDNSv2.SubmitChangeList(context.Background(), zone)
+			// The zone changed underneath the change list, start over.
+			if resp != nil && resp.StatusCode == 409 {
+				log.Printf("[DEBUG] Akamai FastDNS change list for %s became stale before submit, retrying", zone)
+				return 42, "stale", nil
+			}
+
+			if err != nil {
+				conn.FastDNSv2.DeleteChangeList(context.Background(), zone)
+				e := fmt.Errorf("error submitting Akamai FastDNS change list: %s", err)
+				return 42, "failure", e
+			}
+
+			return 42, "submitted", nil
+		},
+	}
+
+	_, err := wait.WaitForState()
+	return err
 }
 
 func resourceAkamaiFastDNSZoneRead(d *schema.ResourceData, m interface{}) error {
 	conn := m.(*AkamaiClient).client
 
-	input := d.Get("zone").(string)
+	input := d.Id()
 	log.Printf("[DEBUG] Getting Akamai FastDNS Hosted Zone: %s", input)
 
 	output, resp, err := conn.FastDNSv2.GetZone(context.Background(), input)
-	if resp.StatusCode == 404 {
+	if resp != nil && resp.StatusCode == 404 {
 		log.Printf("[WARN] Akamai FastDNS Zone (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -130,10 +346,33 @@ func resourceAkamaiFastDNSZoneRead(d *schema.ResourceData, m interface{}) error
 	}
 	log.Printf("[DEBUG] Listing zone returned from Akamai: %v", *output.Zone)
 
-	d.Set("comment", *output.Comment)
+	d.Set("comment", stringValue(output.Comment))
 	d.Set("zone", *output.Zone)
-	d.Set("type", *output.Type)
-	d.Set("contract_id", *output.ContractID)
+	d.Set("type", stringValue(output.Type))
+	d.Set("contract_id", stringValue(output.ContractID))
+	d.Set("activation_state", stringValue(output.ActivationState))
+
+	ns, err := getFastDNSZoneDelegationSet(conn, output)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("name_servers", ns); err != nil {
+		return fmt.Errorf("error setting name_servers: %s", err)
+	}
+
+	// Only primary zones have an SOA record that we manage. Zones that are
+	// still NEW were never bootstrapped and have no SOA record yet.
+	if stringValue(output.Type) == "PRIMARY" && stringValue(output.ActivationState) != "NEW" {
+		soa, err := getFastDNSZoneSOA(conn, d.Id())
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("soa", flattenSOA(soa)); err != nil {
+			return fmt.Errorf("error setting soa: %s", err)
+		}
+		d.Set("soa_serial", soa.Serial)
+	}
 
 	return nil
 }
@@ -143,6 +382,21 @@ func resourceAkamaiFastDNSZoneUpdate(d *schema.ResourceData, m interface{}) erro
 
 	d.Partial(true)
 
+	// A zone that is still NEW never had its initial change list
+	// submitted, most likely because an earlier create failed part way.
+	// Bootstrapping applies the SOA parameters as well.
+	state, _ := d.GetChange("activation_state")
+	bootstrap := state.(string) == "NEW"
+	if bootstrap {
+		log.Printf("[DEBUG] Resuming bootstrap of Akamai FastDNS Hosted Zone: %s", d.Id())
+		err := bootstrapFastDNSZone(conn, d.Id(), d.Get("soa").([]interface{}), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("soa")
+	}
+
 	if d.HasChange("comment") {
 		input := &akamai.ZoneCreateRequest{
 			Zone:    d.Id(),
@@ -157,6 +411,24 @@ func resourceAkamaiFastDNSZoneUpdate(d *schema.ResourceData, m interface{}) erro
 		d.SetPartial("comment")
 	}
 
+	if !bootstrap && d.HasChange("soa") {
+		err := updateFastDNSZoneSOA(conn, d.Id(), d.Get("soa").([]interface{}))
+		if err != nil {
+			return err
+		}
+
+		d.SetPartial("soa")
+	}
+
+	// Finish waiting for zones that did not become active during create.
+	if state.(string) != "ACTIVE" && d.Get("wait_for_activation").(bool) {
+		log.Printf("[DEBUG] Waiting for Akamai FastDNS Hosted Zone (%s) to become active", d.Id())
+		_, err := waitForFastDNSZoneActivation(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error waiting for Akamai FastDNS Zone (%s) to become active: %s", d.Id(), err)
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceAkamaiFastDNSZoneRead(d, m)
@@ -165,10 +437,18 @@ func resourceAkamaiFastDNSZoneUpdate(d *schema.ResourceData, m interface{}) erro
 func resourceAkamaiFastDNSZoneDelete(d *schema.ResourceData, m interface{}) error {
 	conn := m.(*AkamaiClient).client
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Akamai FastDNS Zone (%s) has deletion_protection enabled. Set deletion_protection to false and apply before destroying it.", d.Id())
+	}
+
+	// Without force Akamai refuses to delete zones which still contain
+	// records other than SOA and NS.
+	force := d.Get("force_destroy").(bool)
+
 	// send the delete zone request. Akamai throws 500s sometimes, until
 	// they fix that bug we must retry until HTTP 201 (or timeout)
 	log.Printf("[DEBUG] Deleting Akamai FastDNS Hosted Zone: %s", d.Id())
-	output, err := deleteFastDNSZone(conn, d.Id(), false)
+	output, err := deleteFastDNSZone(conn, d.Id(), force)
 	if err != nil {
 		return err
 	}
@@ -183,6 +463,304 @@ func resourceAkamaiFastDNSZoneDelete(d *schema.ResourceData, m interface{}) erro
 	return nil
 }
 
+// soaRecord holds the parsed rdata and TTL of a zone's SOA record set.
+type soaRecord struct {
+	MName   string
+	RName   string
+	Serial  int
+	Refresh int
+	Retry   int
+	Expire  int
+	Minimum int
+	TTL     int
+}
+
+// parseSOARecordSet parses the single rdata entry of an SOA record set,
+// which is in the form "mname rname serial refresh retry expire minimum".
+func parseSOARecordSet(rs *akamai.RecordSet) (*soaRecord, error) {
+	if rs == nil || len(rs.Rdata) != 1 || rs.Rdata[0] == nil {
+		return nil, fmt.Errorf("SOA record set must contain exactly one rdata entry")
+	}
+
+	fields := strings.Fields(*rs.Rdata[0])
+	if len(fields) != 7 {
+		return nil, fmt.Errorf("invalid SOA rdata: %q", *rs.Rdata[0])
+	}
+
+	ints := make([]int, 5)
+	for i, f := range fields[2:] {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SOA rdata: %q: %s", *rs.Rdata[0], err)
+		}
+		ints[i] = n
+	}
+
+	soa := &soaRecord{
+		MName:   fields[0],
+		RName:   fields[1],
+		Serial:  ints[0],
+		Refresh: ints[1],
+		Retry:   ints[2],
+		Expire:  ints[3],
+		Minimum: ints[4],
+	}
+	if rs.TTL != nil {
+		soa.TTL = *rs.TTL
+	}
+
+	return soa, nil
+}
+
+// Rdata returns the SOA record in presentation format.
+func (s *soaRecord) Rdata() string {
+	return fmt.Sprintf("%s %s %d %d %d %d %d", s.MName, s.RName, s.Serial, s.Refresh, s.Retry, s.Expire, s.Minimum)
+}
+
+// soaContactToRname converts a contact email address such as
+// hostmaster@example.com into the SOA rname form hostmaster.example.com.
+// Values already in rname form are only normalized.
+func soaContactToRname(contact string) string {
+	rn := strings.ToLower(strings.TrimSuffix(contact, "."))
+	if i := strings.Index(rn, "@"); i >= 0 {
+		local := strings.Replace(rn[:i], ".", "\\.", -1)
+		rn = local + "." + rn[i+1:]
+	}
+	if rn == "" {
+		return rn
+	}
+	return rn + "."
+}
+
+// expandSOA applies the values set in the soa block on top of an existing
+// SOA record. Unset values keep what Akamai currently has.
+func expandSOA(l []interface{}, soa *soaRecord) {
+	if len(l) == 0 || l[0] == nil {
+		return
+	}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["contact"].(string); ok && v != "" {
+		soa.RName = soaContactToRname(v)
+	}
+	if v, ok := m["ttl"].(int); ok && v != 0 {
+		soa.TTL = v
+	}
+	if v, ok := m["refresh"].(int); ok && v != 0 {
+		soa.Refresh = v
+	}
+	if v, ok := m["retry"].(int); ok && v != 0 {
+		soa.Retry = v
+	}
+	if v, ok := m["expire"].(int); ok && v != 0 {
+		soa.Expire = v
+	}
+	if v, ok := m["minimum"].(int); ok && v != 0 {
+		soa.Minimum = v
+	}
+}
+
+func flattenSOA(soa *soaRecord) []interface{} {
+	m := map[string]interface{}{
+		"contact": soa.RName,
+		"ttl":     soa.TTL,
+		"refresh": soa.Refresh,
+		"retry":   soa.Retry,
+		"expire":  soa.Expire,
+		"minimum": soa.Minimum,
+	}
+	return []interface{}{m}
+}
+
+// getFastDNSZoneSOA reads and parses the SOA record of a primary zone.
+func getFastDNSZoneSOA(conn *akamai.Client, zone string) (*soaRecord, error) {
+	rso := &akamai.RecordSetOptions{
+		Zone: zone,
+		Name: zone,
+		Type: "SOA",
+	}
+
+	rs, _, err := conn.FastDNSv2.GetRecordSet(context.Background(), rso)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Akamai FastDNS Zone (%s) SOA record: %s", zone, err)
+	}
+
+	return parseSOARecordSet(rs)
+}
+
+// getFastDNSZoneNameServers returns the authoritative name servers of a
+// primary zone from its apex NS record set, without trailing dots.
+func getFastDNSZoneNameServers(conn *akamai.Client, zone string) ([]string, error) {
+	rso := &akamai.RecordSetOptions{
+		Zone: zone,
+		Name: zone,
+		Type: akamai.RRTypeNs,
+	}
+
+	rs, _, err := conn.FastDNSv2.GetRecordSet(context.Background(), rso)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Akamai FastDNS Zone (%s) NS records: %s", zone, err)
+	}
+
+	ns := make([]string, 0, len(rs.Rdata))
+	for _, r := range rs.Rdata {
+		if r != nil {
+			ns = append(ns, strings.ToLower(strings.TrimSuffix(*r, ".")))
+		}
+	}
+	sort.Strings(ns)
+
+	return ns, nil
+}
+
+// getFastDNSContractNameServers returns the name servers Akamai assigns to
+// zones in the contract, without trailing dots.
+func getFastDNSContractNameServers(conn *akamai.Client, cid string) ([]string, error) {
+	output, _, err := listAuthorities(context.Background(), conn, cid)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Akamai FastDNS authorities for contract (%s): %s", cid, err)
+	}
+
+	ns := []string{}
+	if output != nil {
+		for _, c := range output.Contracts {
+			if c == nil || c.ContractID == nil || *c.ContractID != cid {
+				continue
+			}
+			for _, a := range c.Authorities {
+				if a != nil {
+					ns = append(ns, strings.ToLower(strings.TrimSuffix(*a, ".")))
+				}
+			}
+		}
+	}
+	sort.Strings(ns)
+
+	return ns, nil
+}
+
+// getFastDNSZoneDelegationSet returns the name servers to delegate the zone
+// to at its registrar. The apex NS record set is used for primary zones,
+// otherwise the contract's authorities.
+func getFastDNSZoneDelegationSet(conn *akamai.Client, zone *akamai.ZoneMetadata) ([]string, error) {
+	if zone.Type != nil && *zone.Type == "PRIMARY" {
+		ns, err := getFastDNSZoneNameServers(conn, *zone.Zone)
+		if err == nil && len(ns) > 0 {
+			return ns, nil
+		}
+		log.Printf("[DEBUG] Falling back to contract authorities for Akamai FastDNS Zone (%s): %v", *zone.Zone, err)
+	}
+
+	if zone.ContractID == nil {
+		return nil, fmt.Errorf("Akamai FastDNS Zone (%s) has no contract", *zone.Zone)
+	}
+
+	return getFastDNSContractNameServers(conn, *zone.ContractID)
+}
+
+// applyFastDNSZoneChangeListSOA edits the SOA record of the zone's pending
+// change list, so it is applied when the change list is submitted.
+func applyFastDNSZoneChangeListSOA(conn *akamai.Client, zone string, l []interface{}) error {
+	clo := &akamai.ChangeListOptions{
+		Types: "SOA",
+	}
+
+	rs, _, err := conn.FastDNSv2.GetChangeListRecordSets(context.Background(), zone, clo)
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS change list SOA record: %s", err)
+	}
+
+	if len(rs.Recordsets) == 0 {
+		return fmt.Errorf("no SOA record found in Akamai FastDNS change list for zone (%s)", zone)
+	}
+
+	soa, err := parseSOARecordSet(rs.Recordsets[0])
+	if err != nil {
+		return err
+	}
+	expandSOA(l, soa)
+
+	change := &recordSetChange{
+		Name:  zone,
+		Type:  "SOA",
+		Op:    recordSetChangeEdit,
+		TTL:   soa.TTL,
+		Rdata: []string{soa.Rdata()},
+	}
+
+	_, err = addChangeListRecordSetChange(context.Background(), conn, zone, change)
+	if err != nil {
+		return fmt.Errorf("error adding SOA record to Akamai FastDNS change list: %s", err)
+	}
+
+	return nil
+}
+
+// updateFastDNSZoneSOA replaces the SOA record of an existing zone.
+func updateFastDNSZoneSOA(conn *akamai.Client, zone string, l []interface{}) error {
+	rso := &akamai.RecordSetOptions{
+		Zone: zone,
+		Name: zone,
+		Type: "SOA",
+	}
+
+	rs, _, err := conn.FastDNSv2.GetRecordSet(context.Background(), rso)
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS Zone (%s) SOA record: %s", zone, err)
+	}
+
+	soa, err := parseSOARecordSet(rs)
+	if err != nil {
+		return err
+	}
+	expandSOA(l, soa)
+
+	rec := &akamai.RecordSetCreateRequest{
+		Zone:  zone,
+		Name:  zone,
+		Type:  "SOA",
+		TTL:   soa.TTL,
+		Rdata: []string{soa.Rdata()},
+	}
+
+	log.Printf("[DEBUG] Updating Akamai FastDNS Zone (%s) SOA record: %s", zone, rec.Rdata[0])
+	_, _, err = conn.FastDNSv2.UpdateRecordSet(context.Background(), rec)
+	if err != nil {
+		return fmt.Errorf("error updating Akamai FastDNS Zone (%s) SOA record: %s", zone, err)
+	}
+
+	return nil
+}
+
+func waitForFastDNSZoneActivation(conn *akamai.Client, zone string, timeout time.Duration) (interface{}, error) {
+	wait := resource.StateChangeConf{
+		Pending:    []string{"NEW", "PENDING", "rejected"},
+		Target:     []string{"ACTIVE"},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			output, resp, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+			// Akamai throws intermittent HTTP 500 and 503 errors, retry those.
+			if resp != nil && (resp.StatusCode == 500 || resp.StatusCode == 503) {
+				return 42, "rejected", nil
+			}
+
+			if err != nil {
+				e := fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", zone, err)
+				return 42, "failure", e
+			}
+
+			if output == nil || output.ActivationState == nil {
+				return 42, "PENDING", nil
+			}
+
+			log.Printf("[DEBUG] Akamai FastDNS Hosted Zone (%s) activation state: %s", zone, *output.ActivationState)
+			return output, *output.ActivationState, nil
+		},
+	}
+	return wait.WaitForState()
+}
+
 func deleteFastDNSZone(conn *akamai.Client, zone string, force bool) (interface{}, error) {
 	wait := resource.StateChangeConf{
 		Pending:    []string{"rejected"},
@@ -232,14 +810,75 @@ func checkDeleteFastDNSZone(conn *akamai.Client, rid string) (interface{}, error
 				return 42, "failure", e
 			}
 
-			if !*zs.IsComplete {
+			// A failed zone will never be deleted by this request, so stop
+			// polling and report why Akamai refused it.
+			if zs.FailureCount != nil && *zs.FailureCount > 0 {
+				return zs, "failure", fastDNSZoneDeleteFailure(conn, rid, zs)
+			}
+
+			if zs.IsComplete == nil || !*zs.IsComplete {
 				// if the delete has not completed, retry
 				return 42, "rejected", nil
 			}
 
-			return 42, "accepted", nil
+			return zs, "accepted", nil
 		},
 	}
 
 	return wait.WaitForState()
 }
+
+// fastDNSZoneDeleteFailure builds an error from the result of a failed
+// delete request, including the reason Akamai gave for each failed zone.
+func fastDNSZoneDeleteFailure(conn *akamai.Client, rid string, zs *akamai.ZoneDeleteResponse) error {
+	res, _, err := conn.FastDNSv2.DeleteZoneResult(context.Background(), rid)
+	if err != nil {
+		log.Printf("[WARN] Unable to get Akamai FastDNS delete result (%s): %s", rid, err)
+		return fmt.Errorf("Akamai FastDNS delete request (%s) failed for %d of %d zones", rid, *zs.FailureCount, intValue(zs.ZonesSubmitted))
+	}
+
+	return fmt.Errorf("Akamai FastDNS delete request (%s) failed: %s", rid, formatFastDNSZoneDeleteFailures(res))
+}
+
+// formatFastDNSZoneDeleteFailures lists each failed zone with its reason.
+func formatFastDNSZoneDeleteFailures(res *akamai.ZoneDeleteResult) string {
+	failures := make([]string, 0, len(res.FailedZones))
+	for _, f := range res.FailedZones {
+		if f == nil || f.Zone == nil {
+			continue
+		}
+
+		reason := "no reason given"
+		if f.FailureReason != nil && *f.FailureReason != "" {
+			reason = *f.FailureReason
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", *f.Zone, reason))
+	}
+
+	if len(failures) == 0 {
+		return "no failure details returned"
+	}
+
+	return strings.Join(failures, "; ")
+}
+
+func intValue(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func boolValue(b *bool) bool {
+	if b == nil {
+		return false
+	}
+	return *b
+}
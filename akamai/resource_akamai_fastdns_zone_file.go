@@ -0,0 +1,145 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAkamaiFastDNSZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAkamaiFastDNSZoneFileCreate,
+		Read:   resourceAkamaiFastDNSZoneFileRead,
+		Update: resourceAkamaiFastDNSZoneFileUpdate,
+		Delete: resourceAkamaiFastDNSZoneFileDelete,
+
+		CustomizeDiff: resourceAkamaiFastDNSZoneFileCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"zone_file": {
+				Type:     schema.TypeString,
+				Required: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return zoneFilesEquivalent(old, new, d.Get("zone").(string))
+				},
+			},
+
+			// records holds the canonical form of zone_file, one record per
+			// element, so that plans show which records change.
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceAkamaiFastDNSZoneFileCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("zone_file") || !d.NewValueKnown("zone") {
+		return d.SetNewComputed("records")
+	}
+
+	if !d.HasChange("zone_file") {
+		return nil
+	}
+
+	records, err := parseZoneFile(d.Get("zone_file").(string), d.Get("zone").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing zone_file: %s", err)
+	}
+
+	return d.SetNew("records", canonicalZoneFileRecords(records, true, true))
+}
+
+func resourceAkamaiFastDNSZoneFileCreate(d *schema.ResourceData, m interface{}) error {
+	zone := d.Get("zone").(string)
+
+	err := uploadFastDNSZoneFile(d, m)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(zone)
+
+	return resourceAkamaiFastDNSZoneFileRead(d, m)
+}
+
+func resourceAkamaiFastDNSZoneFileRead(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Id()
+
+	log.Printf("[DEBUG] Downloading Akamai FastDNS Zone (%s) master file", zone)
+	text, resp, err := getZoneFile(context.Background(), conn, zone)
+	if resp != nil && resp.StatusCode == 404 {
+		log.Printf("[WARN] Akamai FastDNS Zone (%s) not found, removing zone file from state", zone)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error downloading Akamai FastDNS Zone (%s) master file: %s", zone, err)
+	}
+
+	records, err := parseZoneFile(text, zone)
+	if err != nil {
+		return fmt.Errorf("error parsing Akamai FastDNS Zone (%s) master file: %s", zone, err)
+	}
+
+	d.Set("zone", zone)
+
+	// Keep the configured text unless the zone has drifted from it, so
+	// formatting and comments in the configuration don't show as changes.
+	if !zoneFilesEquivalent(d.Get("zone_file").(string), text, zone) {
+		d.Set("zone_file", strings.Join(canonicalZoneFileRecords(records, true, false), "\n")+"\n")
+	}
+
+	if err := d.Set("records", canonicalZoneFileRecords(records, true, true)); err != nil {
+		return fmt.Errorf("error setting records: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAkamaiFastDNSZoneFileUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("zone_file") {
+		err := uploadFastDNSZoneFile(d, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceAkamaiFastDNSZoneFileRead(d, m)
+}
+
+// A zone can't be left without records, so destroying the resource only
+// stops managing the zone's master file.
+func resourceAkamaiFastDNSZoneFileDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Removing Akamai FastDNS Zone (%s) master file from state, records are left in place", d.Id())
+	return nil
+}
+
+func uploadFastDNSZoneFile(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+
+	log.Printf("[DEBUG] Uploading Akamai FastDNS Zone (%s) master file", zone)
+	_, err := uploadZoneFile(context.Background(), conn, zone, d.Get("zone_file").(string))
+	if err != nil {
+		return fmt.Errorf("error uploading Akamai FastDNS Zone (%s) master file: %s", zone, err)
+	}
+
+	return nil
+}
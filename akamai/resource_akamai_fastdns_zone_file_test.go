@@ -0,0 +1,56 @@
+package akamai
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAkamaiFastDNSZoneFile_basic(t *testing.T) {
+	resourceName := "akamai_fastdns_zone_file.test"
+	zoneName := fmt.Sprintf("testzone-file-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSZoneFileConfig(zoneName, "127.0.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone", zoneName),
+					resource.TestCheckResourceAttrSet(resourceName, "records.0"),
+				),
+			},
+			{
+				Config: testAccFastDNSZoneFileConfig(zoneName, "127.0.0.11"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "zone", zoneName),
+				),
+			},
+		},
+	})
+}
+
+func testAccFastDNSZoneFileConfig(zone, ip string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "main" {
+  zone = "%[1]s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_zone_file" "test" {
+  zone = "${akamai_fastdns_zone.main.zone}"
+
+  zone_file = <<EOT
+$TTL 300
+@   IN SOA a1-49.akam.net. hostmaster.%[1]s. 1 3600 600 604800 300
+@   IN NS  a1-49.akam.net.
+www IN A   %[2]s
+EOT
+}
+`, zone, ip)
+}
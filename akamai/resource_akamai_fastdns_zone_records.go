@@ -0,0 +1,436 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/config/hcl2shim"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/terraform-provider-akamai/akamai/internal/dnsname"
+)
+
+func resourceAkamaiFastDNSZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAkamaiFastDNSZoneRecordsCreate,
+		Read:   resourceAkamaiFastDNSZoneRecordsRead,
+		Update: resourceAkamaiFastDNSZoneRecordsUpdate,
+		Delete: resourceAkamaiFastDNSZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceAkamaiFastDNSZoneRecordsCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// record holds every record set of the zone. Record sets in the
+			// zone that aren't listed here are deleted.
+			"record": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     fastDNSRecordSetElem(),
+			},
+
+			// ignore lists record sets owned by other systems, they are
+			// neither read nor changed.
+			"ignore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						// All types are ignored when type is not set.
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			// The SOA record is always left alone, it is managed by
+			// akamai_fastdns_zone or akamai_fastdns_soa.
+			"manage_apex_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// fastDNSRecordSetElem is the schema of a single record set in resources
+// managing several record sets at once.
+func fastDNSRecordSetElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(fastDNSRecordTypes, false),
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"rdata": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceAkamaiFastDNSZoneRecordsCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("zone") || !d.NewValueKnown("record") {
+		return nil
+	}
+
+	zone := d.Get("zone").(string)
+	records := d.Get("record").(*schema.Set).List()
+	ignore := d.Get("ignore").([]interface{})
+	manageApexNS := d.Get("manage_apex_ns").(bool)
+
+	err := validateFastDNSRecordSets(zone, records, func(fqdn, typeStr string) error {
+		if !isManagedFastDNSRecordSet(zone, fqdn, typeStr, ignore, manageApexNS) {
+			return fmt.Errorf("record set %s %s is ignored or not managed by akamai_fastdns_zone_records", fqdn, typeStr)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Creating the resource deletes the managed record sets that aren't
+	// configured, which a plan for a new resource can't show. Refuse to
+	// create it until those record sets are imported, configured or ignored.
+	if d.Id() == "" {
+		conn := m.(*AkamaiClient).client
+		return checkUnconfiguredFastDNSRecordSets(conn, zone, records, ignore, manageApexNS)
+	}
+	return nil
+}
+
+// checkUnconfiguredFastDNSRecordSets returns an error listing the managed
+// record sets of the zone that aren't configured.
+func checkUnconfiguredFastDNSRecordSets(conn *akamai.Client, zone string, records, ignore []interface{}, manageApexNS bool) error {
+	// The zone may be created in the same apply, it has no records yet.
+	_, resp, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+	if resp != nil && resp.StatusCode == 404 {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", zone, err)
+	}
+
+	current, err := listManagedFastDNSRecordSets(conn, zone, ignore, manageApexNS)
+	if err != nil {
+		return err
+	}
+
+	var unconfigured []string
+	for _, c := range diffFastDNSRecordSets(current, expandFastDNSRecordSets(records, zone)) {
+		if c.Op == recordSetChangeDelete && !isApexNSRecord(c.Name, zone, c.Type) {
+			unconfigured = append(unconfigured, fmt.Sprintf("%s %s", c.Name, c.Type))
+		}
+	}
+	if len(unconfigured) > 0 {
+		return fmt.Errorf("Akamai FastDNS Zone (%s) has record sets that akamai_fastdns_zone_records would delete: %s. "+
+			"Import the zone's records with terraform import, or add them to record or ignore first.",
+			zone, strings.Join(unconfigured, ", "))
+	}
+	return nil
+}
+
+// validateFastDNSRecordSets checks the names and rdata of record sets and
+// that none of them is listed twice. check is called for every record set
+// for any further validation.
+func validateFastDNSRecordSets(zone string, records []interface{}, check func(fqdn, typeStr string) error) error {
+	seen := make(map[string]bool, len(records))
+	for _, r := range records {
+		rec := r.(map[string]interface{})
+		typeStr := rec["type"].(string)
+
+		fqdn := expandRecordName(rec["name"].(string), zone)
+		if err := dnsname.Validate(fqdn); err != nil {
+			return fmt.Errorf("invalid record name: %s", err)
+		}
+
+		key := recordSetKey(fqdn, typeStr)
+		if seen[key] {
+			return fmt.Errorf("record set %s %s is listed more than once", fqdn, typeStr)
+		}
+		seen[key] = true
+
+		for _, v := range rec["rdata"].([]interface{}) {
+			s, _ := v.(string)
+			if s == hcl2shim.UnknownVariableValue {
+				continue
+			}
+			if err := validateRdata(typeStr, s); err != nil {
+				return err
+			}
+		}
+
+		if check != nil {
+			if err := check(fqdn, typeStr); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceAkamaiFastDNSZoneRecordsCreate(d *schema.ResourceData, m interface{}) error {
+	zone := d.Get("zone").(string)
+
+	err := applyFastDNSZoneRecords(d, m, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(zone)
+
+	return resourceAkamaiFastDNSZoneRecordsRead(d, m)
+}
+
+func resourceAkamaiFastDNSZoneRecordsRead(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Id()
+
+	_, resp, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+	if resp != nil && resp.StatusCode == 404 {
+		log.Printf("[WARN] Akamai FastDNS Zone (%s) not found, removing zone records from state", zone)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", zone, err)
+	}
+
+	recordSets, err := listManagedFastDNSRecordSets(conn, zone, d.Get("ignore").([]interface{}), d.Get("manage_apex_ns").(bool))
+	if err != nil {
+		return err
+	}
+
+	d.Set("zone", zone)
+
+	records := flattenFastDNSRecordSets(recordSets, zone, d.Get("record").(*schema.Set).List())
+	if err := d.Set("record", records); err != nil {
+		return fmt.Errorf("error setting record: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAkamaiFastDNSZoneRecordsUpdate(d *schema.ResourceData, m interface{}) error {
+	if d.HasChange("record") || d.HasChange("ignore") || d.HasChange("manage_apex_ns") {
+		err := applyFastDNSZoneRecords(d, m, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceAkamaiFastDNSZoneRecordsRead(d, m)
+}
+
+// Destroying the resource deletes the record sets it manages, records that
+// are ignored, the SOA record and by default the apex NS records stay.
+func resourceAkamaiFastDNSZoneRecordsDelete(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+	ignore := d.Get("ignore").([]interface{})
+	manageApexNS := d.Get("manage_apex_ns").(bool)
+
+	log.Printf("[DEBUG] Deleting records of Akamai FastDNS Zone (%s)", zone)
+	err := applyFastDNSRecordSetChanges(conn, zone, d.Timeout(schema.TimeoutDelete), func() ([]*recordSetChange, error) {
+		current, err := listManagedFastDNSRecordSets(conn, zone, ignore, manageApexNS)
+		if err != nil {
+			return nil, err
+		}
+		return withoutApexNSDeletes(zone, diffFastDNSRecordSets(current, nil)), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting Akamai FastDNS Zone (%s) records: %s", zone, err)
+	}
+
+	return nil
+}
+
+// applyFastDNSZoneRecords makes the managed record sets of the zone match
+// the configuration in a single change list.
+func applyFastDNSZoneRecords(d *schema.ResourceData, m interface{}, timeout time.Duration) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+	ignore := d.Get("ignore").([]interface{})
+	manageApexNS := d.Get("manage_apex_ns").(bool)
+	desired := expandFastDNSRecordSets(d.Get("record").(*schema.Set).List(), zone)
+
+	log.Printf("[DEBUG] Updating records of Akamai FastDNS Zone (%s)", zone)
+	err := applyFastDNSRecordSetChanges(conn, zone, timeout, func() ([]*recordSetChange, error) {
+		current, err := listManagedFastDNSRecordSets(conn, zone, ignore, manageApexNS)
+		if err != nil {
+			return nil, err
+		}
+		return withoutApexNSDeletes(zone, diffFastDNSRecordSets(current, desired)), nil
+	})
+	if err != nil {
+		return fmt.Errorf("error updating Akamai FastDNS Zone (%s) records: %s", zone, err)
+	}
+
+	return nil
+}
+
+// listManagedFastDNSRecordSets lists the record sets of the zone that
+// akamai_fastdns_zone_records manages.
+func listManagedFastDNSRecordSets(conn *akamai.Client, zone string, ignore []interface{}, manageApexNS bool) ([]*akamai.RecordSet, error) {
+	recordSets, err := listFastDNSRecordSets(conn, zone, &akamai.ListZoneRecordSetOptions{
+		PageSize: fastDNSListPageSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var managed []*akamai.RecordSet
+	for _, rs := range recordSets {
+		if rs == nil || rs.Name == nil || rs.Type == nil {
+			continue
+		}
+		if isManagedFastDNSRecordSet(zone, *rs.Name, *rs.Type, ignore, manageApexNS) {
+			managed = append(managed, rs)
+		}
+	}
+	return managed, nil
+}
+
+// isManagedFastDNSRecordSet reports whether a record set is managed, as
+// opposed to being the SOA record, an apex NS record that isn't managed or
+// one of the ignored record sets.
+func isManagedFastDNSRecordSet(zone, fqdn, typeStr string, ignore []interface{}, manageApexNS bool) bool {
+	if typeStr == "SOA" {
+		return false
+	}
+	if !manageApexNS && isApexNSRecord(dnsname.Normalize(fqdn), zone, typeStr) {
+		return false
+	}
+
+	for _, i := range ignore {
+		rule, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if !dnsname.Equal(expandRecordName(rule["name"].(string), zone), fqdn) {
+			continue
+		}
+		if t, _ := rule["type"].(string); t == "" || t == typeStr {
+			return false
+		}
+	}
+	return true
+}
+
+// expandFastDNSRecordSets turns record set blocks into the record sets to
+// submit, with fully qualified names and rdata as Akamai expects it.
+func expandFastDNSRecordSets(records []interface{}, zone string) []*recordSetChange {
+	l := make([]*recordSetChange, 0, len(records))
+	for _, r := range records {
+		rec := r.(map[string]interface{})
+		typeStr := rec["type"].(string)
+
+		l = append(l, &recordSetChange{
+			Name:  expandRecordName(rec["name"].(string), zone),
+			Type:  typeStr,
+			TTL:   rec["ttl"].(int),
+			Rdata: expandResourceRecords(rec["rdata"].([]interface{}), typeStr),
+		})
+	}
+	return l
+}
+
+// flattenFastDNSRecordSets turns record sets into record set blocks.
+// Configured blocks that are equivalent to a record set are kept as they
+// are, so their spelling of names and rdata doesn't show as a change.
+func flattenFastDNSRecordSets(recordSets []*akamai.RecordSet, zone string, configured []interface{}) []interface{} {
+	byKey := make(map[string]map[string]interface{}, len(configured))
+	for _, r := range configured {
+		rec := r.(map[string]interface{})
+		byKey[recordSetKey(expandRecordName(rec["name"].(string), zone), rec["type"].(string))] = rec
+	}
+
+	l := make([]interface{}, 0, len(recordSets))
+	for _, rs := range recordSets {
+		typeStr := *rs.Type
+		rdata := cleanResourceRecords(rs.Rdata, typeStr)
+
+		if rec, ok := byKey[recordSetKey(*rs.Name, typeStr)]; ok && rec["ttl"].(int) == intValue(rs.TTL) {
+			current := make([]string, 0, len(rdata))
+			for _, v := range rec["rdata"].([]interface{}) {
+				s, _ := v.(string)
+				current = append(current, s)
+			}
+			if rdataEquivalent(typeStr, current, rdata) {
+				l = append(l, rec)
+				continue
+			}
+		}
+
+		rdataList := make([]interface{}, 0, len(rdata))
+		for _, r := range rdata {
+			rdataList = append(rdataList, r)
+		}
+
+		l = append(l, map[string]interface{}{
+			"name":  dnsname.Relative(*rs.Name, zone),
+			"type":  typeStr,
+			"ttl":   intValue(rs.TTL),
+			"rdata": rdataList,
+		})
+	}
+	return l
+}
+
+// withoutApexNSDeletes drops deletes of the apex NS record set, which
+// Akamai refuses, from changes.
+func withoutApexNSDeletes(zone string, changes []*recordSetChange) []*recordSetChange {
+	l := make([]*recordSetChange, 0, len(changes))
+	for _, c := range changes {
+		if c.Op == recordSetChangeDelete && isApexNSRecord(c.Name, zone, c.Type) {
+			log.Printf("[WARN] Apex NS record set for zone %s cannot be deleted, leaving it in place", zone)
+			continue
+		}
+		l = append(l, c)
+	}
+	return l
+}
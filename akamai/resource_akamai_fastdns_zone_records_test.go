@@ -0,0 +1,285 @@
+package akamai
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+func TestIsManagedFastDNSRecordSet(t *testing.T) {
+	ignore := []interface{}{
+		map[string]interface{}{"name": "_acme-challenge", "type": "TXT"},
+		map[string]interface{}{"name": "vendor", "type": ""},
+	}
+
+	cases := []struct {
+		Name, Type   string
+		ManageApexNS bool
+		Managed      bool
+	}{
+		{"www.porchetta.io", "A", false, true},
+		{"porchetta.io", "SOA", true, false},
+		{"porchetta.io", "NS", false, false},
+		{"porchetta.io", "NS", true, true},
+		{"sub.porchetta.io", "NS", false, true},
+		{"_acme-challenge.porchetta.io", "TXT", false, false},
+		{"_acme-challenge.porchetta.io", "CNAME", false, true},
+		{"VENDOR.porchetta.io.", "CNAME", false, false},
+	}
+
+	for _, tc := range cases {
+		actual := isManagedFastDNSRecordSet("porchetta.io", tc.Name, tc.Type, ignore, tc.ManageApexNS)
+		if actual != tc.Managed {
+			t.Fatalf("name: %s type: %s manage_apex_ns: %t\noutput: %t", tc.Name, tc.Type, tc.ManageApexNS, actual)
+		}
+	}
+}
+
+func TestFlattenFastDNSRecordSets(t *testing.T) {
+	recordSets := []*akamai.RecordSet{
+		testRecordSet("www.porchetta.io", "CNAME", 300, "web.porchetta.io"),
+		testRecordSet("porchetta.io", "A", 300, "127.0.0.1"),
+	}
+	configured := []interface{}{
+		map[string]interface{}{"name": "www.porchetta.io.", "type": "CNAME", "ttl": 300, "rdata": []interface{}{"Web.porchetta.io."}},
+	}
+
+	l := flattenFastDNSRecordSets(recordSets, "porchetta.io", configured)
+	if len(l) != 2 {
+		t.Fatalf("expected 2 record sets, got %d", len(l))
+	}
+
+	www := l[0].(map[string]interface{})
+	if www["name"] != "www.porchetta.io." || www["rdata"].([]interface{})[0] != "Web.porchetta.io." {
+		t.Fatalf("expected configured spelling to be kept, got %v", www)
+	}
+
+	apex := l[1].(map[string]interface{})
+	if apex["name"] != "@" || apex["ttl"] != 300 {
+		t.Fatalf("expected unmanaged apex record, got %v", apex)
+	}
+}
+
+func TestAccAkamaiFastDNSZoneRecords_basic(t *testing.T) {
+	resourceName := "akamai_fastdns_zone_records.test"
+	zoneName := fmt.Sprintf("testzone-records-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSZoneRecordsConfig(zoneName, "127.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+				),
+			},
+			{
+				// The out of band record is planned for removal, the
+				// ignored one is left alone.
+				PreConfig: func() {
+					testAccFastDNSZoneRecordsCreateOutOfBand(t, zoneName, "rogue."+zoneName)
+					testAccFastDNSZoneRecordsCreateOutOfBand(t, zoneName, "_acme-challenge."+zoneName)
+				},
+				Config:             testAccFastDNSZoneRecordsConfig(zoneName, "127.0.0.1"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccFastDNSZoneRecordsConfig(zoneName, "127.0.0.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					testAccCheckFastDNSRecordGone(zoneName, "rogue."+zoneName, "TXT"),
+				),
+			},
+			{
+				// Without the ignore list the import also picks up the
+				// _acme-challenge record set.
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateCheck: testAccCheckFastDNSZoneRecordsImported(map[string]string{
+					"www/A":               "127.0.0.2",
+					"_dmarc/TXT":          "",
+					"_acme-challenge/TXT": "",
+				}),
+			},
+		},
+	})
+}
+
+func TestAccAkamaiFastDNSZoneRecords_unconfigured(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-records-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSZoneRecordsConfig_zone(zoneName),
+			},
+			{
+				// Creating the resource would delete the existing record
+				// set without the plan showing it.
+				PreConfig: func() {
+					testAccFastDNSZoneRecordsCreateOutOfBand(t, zoneName, "rogue."+zoneName)
+				},
+				Config:      testAccFastDNSZoneRecordsConfig(zoneName, "127.0.0.1"),
+				ExpectError: regexp.MustCompile("akamai_fastdns_zone_records would delete: rogue"),
+			},
+		},
+	})
+}
+
+func TestAccAkamaiFastDNSZoneRecords_duplicate(t *testing.T) {
+	zoneName := fmt.Sprintf("testzone-records-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFastDNSZoneRecordsConfig_duplicate(zoneName),
+				ExpectError: regexp.MustCompile("listed more than once"),
+			},
+		},
+	})
+}
+
+func testAccFastDNSZoneRecordsCreateOutOfBand(t *testing.T, zone, name string) {
+	conn := testAccProvider.Meta().(*AkamaiClient).client
+
+	rec := &akamai.RecordSetCreateRequest{
+		Zone:  zone,
+		Name:  name,
+		Type:  "TXT",
+		TTL:   300,
+		Rdata: []string{flattenTxtEntry("out of band")},
+	}
+
+	if _, err := createFastDNSRecord(conn, rec); err != nil {
+		t.Fatalf("error creating out of band record %s: %s", name, err)
+	}
+}
+
+// testAccCheckFastDNSZoneRecordsImported checks that exactly the expected
+// record sets were imported. Record sets are keyed by NAME/TYPE and mapped
+// to their first rdata value, which is only compared when not empty.
+func testAccCheckFastDNSZoneRecordsImported(expected map[string]string) resource.ImportStateCheckFunc {
+	return func(s []*terraform.InstanceState) error {
+		if len(s) != 1 {
+			return fmt.Errorf("expected 1 imported resource, got %d", len(s))
+		}
+		attrs := s[0].Attributes
+
+		if attrs["record.#"] != strconv.Itoa(len(expected)) {
+			return fmt.Errorf("expected %d imported record sets, got %s", len(expected), attrs["record.#"])
+		}
+
+		found := make(map[string]bool, len(expected))
+		for k, v := range attrs {
+			if !strings.HasPrefix(k, "record.") || !strings.HasSuffix(k, ".name") {
+				continue
+			}
+			prefix := strings.TrimSuffix(k, "name")
+			key := v + "/" + attrs[prefix+"type"]
+
+			rdata, ok := expected[key]
+			if !ok {
+				return fmt.Errorf("unexpected record set %s imported", key)
+			}
+			if rdata != "" && attrs[prefix+"rdata.0"] != rdata {
+				return fmt.Errorf("expected record set %s to have rdata %s, got %s", key, rdata, attrs[prefix+"rdata.0"])
+			}
+			found[key] = true
+		}
+
+		for key := range expected {
+			if !found[key] {
+				return fmt.Errorf("record set %s was not imported", key)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccFastDNSZoneRecordsConfig_zone(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+  force_destroy = true
+}
+`, zone)
+}
+
+func testAccFastDNSZoneRecordsConfig(zone, ip string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+  force_destroy = true
+}
+
+resource "akamai_fastdns_zone_records" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+
+  record {
+    name = "www"
+    type = "A"
+    ttl = 300
+    rdata = ["%s"]
+  }
+
+  record {
+    name = "_dmarc"
+    type = "TXT"
+    ttl = 300
+    rdata = ["v=DMARC1; p=none"]
+  }
+
+  ignore {
+    name = "_acme-challenge"
+    type = "TXT"
+  }
+}
+`, zone, ip)
+}
+
+func testAccFastDNSZoneRecordsConfig_duplicate(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_zone_records" "test" {
+  zone = "${akamai_fastdns_zone.test.zone}"
+
+  record {
+    name = "www"
+    type = "A"
+    ttl = 300
+    rdata = ["127.0.0.1"]
+  }
+
+  record {
+    name = "www.%s"
+    type = "A"
+    ttl = 300
+    rdata = ["127.0.0.2"]
+  }
+}
+`, zone, zone)
+}
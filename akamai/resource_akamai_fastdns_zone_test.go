@@ -3,15 +3,247 @@ package akamai
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/akamai-sdk-go/akamai/credentials"
 )
 
+func TestParseSOARecordSet(t *testing.T) {
+	rdata := "a1-49.akam.net. hostmaster.porchetta.io. 2019070101 3600 600 604800 300"
+	ttl := 86400
+	rs := &akamai.RecordSet{
+		Rdata: []*string{&rdata},
+		TTL:   &ttl,
+	}
+
+	soa, err := parseSOARecordSet(rs)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := &soaRecord{
+		MName:   "a1-49.akam.net.",
+		RName:   "hostmaster.porchetta.io.",
+		Serial:  2019070101,
+		Refresh: 3600,
+		Retry:   600,
+		Expire:  604800,
+		Minimum: 300,
+		TTL:     86400,
+	}
+	if *soa != *expected {
+		t.Fatalf("got: %#v\nexpected: %#v", soa, expected)
+	}
+
+	if soa.Rdata() != rdata {
+		t.Fatalf("got: %s\nexpected: %s", soa.Rdata(), rdata)
+	}
+
+	bad := "a1-49.akam.net. hostmaster.porchetta.io. 2019070101"
+	rs.Rdata = []*string{&bad}
+	if _, err := parseSOARecordSet(rs); err == nil {
+		t.Fatalf("expected error parsing %q", bad)
+	}
+}
+
+func TestSOAContactToRname(t *testing.T) {
+	cases := []struct {
+		Input, Output string
+	}{
+		{"hostmaster@porchetta.io", "hostmaster.porchetta.io."},
+		{"dns.admin@porchetta.io", "dns\\.admin.porchetta.io."},
+		{"hostmaster.porchetta.io", "hostmaster.porchetta.io."},
+		{"Hostmaster.Porchetta.io.", "hostmaster.porchetta.io."},
+	}
+	for _, tc := range cases {
+		actual := soaContactToRname(tc.Input)
+		if actual != tc.Output {
+			t.Fatalf("input: %s\noutput: %s", tc.Input, actual)
+		}
+	}
+}
+
+func TestFormatFastDNSZoneDeleteFailures(t *testing.T) {
+	zone := "porchetta.io"
+	reason := "ZONE_HAS_RECORDS"
+
+	res := &akamai.ZoneDeleteResult{}
+	if actual := formatFastDNSZoneDeleteFailures(res); actual != "no failure details returned" {
+		t.Fatalf("output: %s", actual)
+	}
+
+	res.FailedZones = append(res.FailedZones, &struct {
+		Zone          *string `json:"zone,omitempty"`
+		FailureReason *string `json:"failiureReason,omitempty"`
+	}{Zone: &zone, FailureReason: &reason})
+
+	if actual := formatFastDNSZoneDeleteFailures(res); actual != "porchetta.io: ZONE_HAS_RECORDS" {
+		t.Fatalf("output: %s", actual)
+	}
+}
+
+// fakeFastDNSZoneAPI serves just enough of the FastDNS API to create,
+// bootstrap and read a single primary zone.
+type fakeFastDNSZoneAPI struct {
+	zone            string
+	activationState string
+	bootstrapFails  bool
+	submits         int
+}
+
+func (f *fakeFastDNSZoneAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	zonePath := "/config-dns/v2/zones/" + f.zone
+	switch {
+	case r.Method == "POST" && r.URL.Path == "/config-dns/v2/zones":
+		f.activationState = "NEW"
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"zone": %q, "type": "PRIMARY", "contractId": "ctr_1"}`, f.zone)
+	case r.Method == "GET" && r.URL.Path == zonePath:
+		fmt.Fprintf(w, `{"zone": %q, "type": "PRIMARY", "contractId": "ctr_1", "comment": "Managed by Terraform", "activationState": %q}`, f.zone, f.activationState)
+	case r.Method == "POST" && r.URL.Path == "/config-dns/v2/changelists":
+		if f.bootstrapFails {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"title": "Bad Request", "status": 400}`)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"zone": %q}`, f.zone)
+	case r.Method == "POST" && r.URL.Path == "/config-dns/v2/changelists/"+f.zone+"/submit":
+		f.submits++
+		f.activationState = "ACTIVE"
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == "GET" && r.URL.Path == zonePath+"/names/"+f.zone+"/types/NS" && f.activationState != "NEW":
+		fmt.Fprintf(w, `{"name": %q, "type": "NS", "ttl": 86400, "rdata": ["a1-1.akam.net."]}`, f.zone)
+	case r.Method == "GET" && r.URL.Path == zonePath+"/names/"+f.zone+"/types/SOA" && f.activationState != "NEW":
+		fmt.Fprintf(w, `{"name": %q, "type": "SOA", "ttl": 86400, "rdata": ["a1-1.akam.net. hostmaster.%s. 1 3600 600 604800 300"]}`, f.zone, f.zone)
+	case r.Method == "GET" && r.URL.Path == "/config-dns/v2/data/authorities":
+		fmt.Fprint(w, `{"contracts": [{"contractId": "ctr_1", "authorities": ["a1-1.akam.net."]}]}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"title": "Not Found", "status": 404}`)
+	}
+}
+
+func TestResourceAkamaiFastDNSZone_resumeBootstrap(t *testing.T) {
+	api := &fakeFastDNSZoneAPI{zone: "porchetta.io", bootstrapFails: true}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+
+	cc := credentials.NewStaticCredentials("secret", "token", "access", "localhost")
+	conn, err := akamai.NewClient(srv.Client(), cc)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	conn.BaseURL, _ = url.Parse(srv.URL + "/")
+	meta := &AkamaiClient{client: conn}
+
+	r := resourceAkamaiFastDNSZone()
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"zone":        api.zone,
+		"contract_id": "ctr_1",
+		"type":        "PRIMARY",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	cfg := terraform.NewResourceConfig(raw)
+
+	diff, err := r.Diff(nil, cfg, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// A failed bootstrap fails the create, but the zone is kept in state
+	// so the next apply can resume.
+	state, err := r.Apply(nil, diff, meta)
+	if err == nil || !regexp.MustCompile("re-run apply to resume").MatchString(err.Error()) {
+		t.Fatalf("expected bootstrap error, got: %v", err)
+	}
+	if state == nil {
+		t.Fatal("expected the zone to be kept in state")
+	}
+	if state.ID != api.zone {
+		t.Fatalf("expected ID %q, got %q", api.zone, state.ID)
+	}
+	if v := state.Attributes["activation_state"]; v != "NEW" {
+		t.Fatalf("expected activation_state NEW, got %q", v)
+	}
+
+	api.bootstrapFails = false
+
+	diff, err = r.Diff(state, cfg, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff == nil || diff.Empty() {
+		t.Fatal("expected a diff to resume the bootstrap")
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("expected an update, not a replacement: %#v", diff)
+	}
+
+	state, err = r.Apply(state, diff, meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if api.submits != 1 {
+		t.Fatalf("expected the change list to be submitted once, got %d", api.submits)
+	}
+	if v := state.Attributes["activation_state"]; v != "ACTIVE" {
+		t.Fatalf("expected activation_state ACTIVE, got %q", v)
+	}
+	if v := state.Attributes["soa_serial"]; v != "1" {
+		t.Fatalf("expected soa_serial 1, got %q", v)
+	}
+}
+
+func TestResourceAkamaiFastDNSZone_importedGroupID(t *testing.T) {
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"zone":        "porchetta.io",
+		"contract_id": "ctr_1",
+		"group_id":    12345,
+		"type":        "PRIMARY",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Imported zones have no group_id, as Akamai doesn't return it.
+	state := &terraform.InstanceState{
+		ID: "porchetta.io",
+		Attributes: map[string]string{
+			"id":                  "porchetta.io",
+			"zone":                "porchetta.io",
+			"contract_id":         "ctr_1",
+			"type":                "PRIMARY",
+			"comment":             "Managed by Terraform",
+			"sign_and_serve":      "false",
+			"wait_for_activation": "true",
+			"deletion_protection": "false",
+			"force_destroy":       "false",
+			"activation_state":    "ACTIVE",
+		},
+	}
+
+	diff, err := resourceAkamaiFastDNSZone().Diff(state, terraform.NewResourceConfig(raw), nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if diff != nil && diff.RequiresNew() {
+		t.Fatalf("expected no replacement, got: %#v", diff)
+	}
+}
+
 func TestAccAkamaiFastDNSZone_basic(t *testing.T) {
 	var zone akamai.ZoneMetadata
 
@@ -29,8 +261,16 @@ func TestAccAkamaiFastDNSZone_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckFastDNSZoneExists(resourceName, &zone),
 					resource.TestCheckResourceAttr(resourceName, "zone", fmt.Sprintf("%s", zoneName)),
+					resource.TestCheckResourceAttr(resourceName, "activation_state", "ACTIVE"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// These only configure how Terraform manages the zone.
+				ImportStateVerifyIgnore: []string{"wait_for_activation", "deletion_protection", "force_destroy"},
+			},
 		},
 	})
 }
@@ -121,6 +361,123 @@ func TestAccAkamaiFastDNSZone_updates(t *testing.T) {
 
 }
 
+func TestAccAkamaiFastDNSZone_soa(t *testing.T) {
+	var zone akamai.ZoneMetadata
+
+	rString := acctest.RandString(8)
+	resourceName := "akamai_fastdns_zone.test"
+	zoneName := fmt.Sprintf("%s.terraformtest.com", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSZoneConfigSOA(zoneName, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastDNSZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "soa.0.contact", fmt.Sprintf("hostmaster.%s.", zoneName)),
+					resource.TestCheckResourceAttr(resourceName, "soa.0.refresh", "3600"),
+					resource.TestCheckResourceAttrSet(resourceName, "soa_serial"),
+				),
+			},
+			{
+				Config: testAccFastDNSZoneConfigSOA(zoneName, 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastDNSZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "soa.0.refresh", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAkamaiFastDNSZone_deletionProtection(t *testing.T) {
+	var zone akamai.ZoneMetadata
+
+	rString := acctest.RandString(8)
+	resourceName := "akamai_fastdns_zone.test"
+	zoneName := fmt.Sprintf("%s.terraformtest.com", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSZoneConfigDeletionProtection(zoneName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastDNSZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccFastDNSZoneConfigDeletionProtection(zoneName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection enabled"),
+			},
+			// disable protection so the zone can be cleaned up
+			{
+				Config: testAccFastDNSZoneConfigDeletionProtection(zoneName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastDNSZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAkamaiFastDNSZone_forceDestroy(t *testing.T) {
+	var zone akamai.ZoneMetadata
+
+	rString := acctest.RandString(8)
+	resourceName := "akamai_fastdns_zone.test"
+	zoneName := fmt.Sprintf("%s.terraformtest.com", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSZoneConfigForceDestroy(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFastDNSZoneExists(resourceName, &zone),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+					// Akamai refuses to delete zones with records other
+					// than SOA and NS unless forced.
+					testAccCreateFastDNSZoneRecordOutOfBand(&zone, "www"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCreateFastDNSZoneRecordOutOfBand adds an A record to the zone
+// without Terraform knowing about it.
+func testAccCreateFastDNSZoneRecordOutOfBand(zone *akamai.ZoneMetadata, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AkamaiClient).client
+
+		rec := &akamai.RecordSetCreateRequest{
+			Zone:  *zone.Zone,
+			Name:  fmt.Sprintf("%s.%s", name, *zone.Zone),
+			Type:  "A",
+			TTL:   300,
+			Rdata: []string{"192.0.2.1"},
+		}
+
+		_, _, err := conn.FastDNSv2.CreateRecordSet(context.Background(), rec)
+		if err != nil {
+			return fmt.Errorf("error creating out of band record in %s: %s", *zone.Zone, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFastDNSZoneDisappears(zone *akamai.ZoneMetadata) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AkamaiClient).client
@@ -221,6 +578,47 @@ resource "akamai_fastdns_zone" "test" {
 `, zoneName, comment)
 }
 
+func testAccFastDNSZoneConfigSOA(zoneName string, refresh int) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%[1]s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+
+  soa {
+    contact = "hostmaster@%[1]s"
+    refresh = %[2]d
+    retry = 600
+    expire = 604800
+    minimum = 300
+    ttl = 86400
+  }
+}
+`, zoneName, refresh)
+}
+
+func testAccFastDNSZoneConfigDeletionProtection(zoneName string, protect bool) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+  deletion_protection = %t
+}
+`, zoneName, protect)
+}
+
+func testAccFastDNSZoneConfigForceDestroy(zoneName string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "test" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+  force_destroy = true
+}
+`, zoneName)
+}
+
 const testAccFastDNSZoneConfigCommentInitial = `
 resource "akamai_fastdns_zone" "test" {
   zone = "zoneconfig.akamaiexample.com"
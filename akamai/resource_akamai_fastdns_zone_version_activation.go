@@ -0,0 +1,204 @@
+package akamai
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+)
+
+// previousZoneVersionID can be given as the version_id to reactivate the
+// version that was active before the current one.
+const previousZoneVersionID = "previous"
+
+func resourceAkamaiFastDNSZoneVersionActivation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAkamaiFastDNSZoneVersionActivationCreate,
+		Read:   resourceAkamaiFastDNSZoneVersionActivationRead,
+		Delete: resourceAkamaiFastDNSZoneVersionActivationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"version_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"activated_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"zone_version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAkamaiFastDNSZoneVersionActivationCreate(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+
+	z, _, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", zone, err)
+	}
+
+	if z.VersionId == nil {
+		return fmt.Errorf("Akamai FastDNS Zone (%s) has no current version", zone)
+	}
+	current := *z.VersionId
+
+	version := d.Get("version_id").(string)
+	if version == previousZoneVersionID {
+		vl, _, err := listZoneVersions(context.Background(), conn, zone)
+		if err != nil {
+			return fmt.Errorf("error listing Akamai FastDNS Zone (%s) versions: %s", zone, err)
+		}
+
+		prev, err := previousZoneVersion(vl.Versions, current)
+		if err != nil {
+			return fmt.Errorf("error finding previous version of Akamai FastDNS Zone (%s): %s", zone, err)
+		}
+		version = *prev.VersionID
+	}
+
+	log.Printf("[DEBUG] Activating Akamai FastDNS Zone (%s) version: %s", zone, version)
+	_, err = activateZoneVersion(context.Background(), conn, zone, version)
+	if err != nil {
+		return fmt.Errorf("error activating Akamai FastDNS Zone (%s) version (%s): %s", zone, version, err)
+	}
+
+	// Reactivating creates a new zone version. The zone can report ACTIVE
+	// while the old version is still live, so wait for the new one. The
+	// activation is only saved once it is live; on failure the concrete
+	// version is reported, as resolving "previous" again would pick the
+	// version that was just replaced.
+	active, err := waitForFastDNSZoneVersionChange(conn, zone, current, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error waiting for Akamai FastDNS Zone (%s) version (%s) to become active, set version_id to %q before re-running apply: %s", zone, version, version, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", zone, version))
+	d.Set("activated_version_id", version)
+	d.Set("zone_version_id", active)
+
+	return resourceAkamaiFastDNSZoneVersionActivationRead(d, m)
+}
+
+func resourceAkamaiFastDNSZoneVersionActivationRead(d *schema.ResourceData, m interface{}) error {
+	conn := m.(*AkamaiClient).client
+	zone := d.Get("zone").(string)
+
+	_, resp, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+	if resp != nil && resp.StatusCode == 404 {
+		log.Printf("[WARN] Akamai FastDNS Zone (%s) not found, removing version activation from state", zone)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", zone, err)
+	}
+
+	return nil
+}
+
+// An activation can't be undone, so destroying it only removes it from state.
+func resourceAkamaiFastDNSZoneVersionActivationDelete(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Removing Akamai FastDNS Zone version activation (%s) from state", d.Id())
+	return nil
+}
+
+// waitForFastDNSZoneVersionChange waits until the zone is ACTIVE on a version
+// other than the given one, and returns the newly active version.
+func waitForFastDNSZoneVersionChange(conn *akamai.Client, zone, version string, timeout time.Duration) (string, error) {
+	wait := resource.StateChangeConf{
+		Pending:    []string{"NEW", "PENDING", "rejected", "unchanged"},
+		Target:     []string{"ACTIVE"},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			output, resp, err := conn.FastDNSv2.GetZone(context.Background(), zone)
+			// Akamai throws intermittent HTTP 500 and 503 errors, retry those.
+			if resp != nil && (resp.StatusCode == 500 || resp.StatusCode == 503) {
+				return 42, "rejected", nil
+			}
+
+			if err != nil {
+				e := fmt.Errorf("error getting Akamai FastDNS Zone (%s): %s", zone, err)
+				return 42, "failure", e
+			}
+
+			if output == nil || output.ActivationState == nil || output.VersionId == nil {
+				return 42, "PENDING", nil
+			}
+
+			if *output.VersionId == version {
+				log.Printf("[DEBUG] Akamai FastDNS Hosted Zone (%s) is still on version %s", zone, version)
+				return 42, "unchanged", nil
+			}
+
+			log.Printf("[DEBUG] Akamai FastDNS Hosted Zone (%s) version %s activation state: %s", zone, *output.VersionId, *output.ActivationState)
+			return *output.VersionId, *output.ActivationState, nil
+		},
+	}
+
+	v, err := wait.WaitForState()
+	if err != nil {
+		return "", err
+	}
+
+	return v.(string), nil
+}
+
+// previousZoneVersion finds the most recently activated version that was
+// activated before the current version.
+func previousZoneVersion(versions []*zoneVersion, current string) (*zoneVersion, error) {
+	var currentActivation string
+	for _, v := range versions {
+		if v != nil && v.VersionID != nil && *v.VersionID == current && v.LastActivationDate != nil {
+			currentActivation = *v.LastActivationDate
+		}
+	}
+
+	var prev *zoneVersion
+	for _, v := range versions {
+		if v == nil || v.VersionID == nil || v.LastActivationDate == nil || *v.VersionID == current {
+			continue
+		}
+
+		// RFC 3339 timestamps in UTC sort lexically.
+		if currentActivation != "" && *v.LastActivationDate >= currentActivation {
+			continue
+		}
+
+		if prev == nil || *v.LastActivationDate > *prev.LastActivationDate {
+			prev = v
+		}
+	}
+
+	if prev == nil {
+		return nil, fmt.Errorf("no version was activated before version %s", current)
+	}
+
+	return prev, nil
+}
@@ -0,0 +1,144 @@
+package akamai
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/trussworks/akamai-sdk-go/akamai"
+	"github.com/trussworks/akamai-sdk-go/akamai/credentials"
+)
+
+func TestPreviousZoneVersion(t *testing.T) {
+	id1, id2, id3, id4 := "v1", "v2", "v3", "v4"
+	d1, d2, d3 := "2019-06-01T10:00:00Z", "2019-06-02T10:00:00Z", "2019-06-03T10:00:00Z"
+	versions := []*zoneVersion{
+		{VersionID: &id4},
+		{VersionID: &id3, LastActivationDate: &d3},
+		{VersionID: &id1, LastActivationDate: &d1},
+		{VersionID: &id2, LastActivationDate: &d2},
+	}
+
+	prev, err := previousZoneVersion(versions, "v3")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if *prev.VersionID != "v2" {
+		t.Fatalf("output: %s, expected v2", *prev.VersionID)
+	}
+
+	if _, err := previousZoneVersion(versions, "v1"); err == nil {
+		t.Fatalf("expected error finding version before v1")
+	}
+}
+
+func TestResourceAkamaiFastDNSZoneVersionActivation_waitFails(t *testing.T) {
+	var gets, activations int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/config-dns/v2/zones/porchetta.io":
+			gets++
+			if gets > 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"title": "Bad Request", "status": 400}`)
+				return
+			}
+			fmt.Fprint(w, `{"zone": "porchetta.io", "activationState": "ACTIVE", "versionId": "v2"}`)
+		case r.Method == "POST" && r.URL.Path == "/config-dns/v2/zones/porchetta.io/versions/v1/recordsets/activate":
+			activations++
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"title": "Not Found", "status": 404}`)
+		}
+	}))
+	defer srv.Close()
+
+	cc := credentials.NewStaticCredentials("secret", "token", "access", "localhost")
+	conn, err := akamai.NewClient(srv.Client(), cc)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	conn.BaseURL, _ = url.Parse(srv.URL + "/")
+	meta := &AkamaiClient{client: conn}
+
+	r := resourceAkamaiFastDNSZoneVersionActivation()
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"zone":       "porchetta.io",
+		"version_id": "v1",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	diff, err := r.Diff(nil, terraform.NewResourceConfig(raw), meta)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// The activation isn't saved until the new version is live.
+	state, err := r.Apply(nil, diff, meta)
+	if err == nil {
+		t.Fatal("expected the wait to fail")
+	}
+	if activations != 1 {
+		t.Fatalf("expected 1 activation, got %d", activations)
+	}
+	if state != nil && state.ID != "" {
+		t.Fatalf("expected no ID, got %q", state.ID)
+	}
+}
+
+func TestAccAkamaiFastDNSZoneVersionActivation_previous(t *testing.T) {
+	resourceName := "akamai_fastdns_zone_version_activation.rollback"
+	zoneName := fmt.Sprintf("testzone-rollback-%s.terraformtest.com", acctest.RandString(8))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFastDNSZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFastDNSZoneVersionActivationConfig(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "activated_version_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "zone_version_id"),
+					// The previous version predates the www record, so
+					// rolling back removes it and Terraform plans to
+					// create it again.
+					testAccCheckFastDNSRecordGone(zoneName, fmt.Sprintf("www.%s", zoneName), "A"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccFastDNSZoneVersionActivationConfig(zone string) string {
+	return fmt.Sprintf(`
+resource "akamai_fastdns_zone" "main" {
+  zone = "%s"
+  contract_id = "G-2LP9RJ3"
+  type = "PRIMARY"
+}
+
+resource "akamai_fastdns_record" "default" {
+  zone = "${akamai_fastdns_zone.main.zone}"
+  name = "www"
+  type = "A"
+  ttl = "30"
+  rdata = ["127.0.0.10"]
+}
+
+resource "akamai_fastdns_zone_version_activation" "rollback" {
+  zone = "${akamai_fastdns_record.default.zone}"
+  version_id = "previous"
+}
+`, zone)
+}
@@ -0,0 +1,368 @@
+package akamai
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// zoneFileRecord is a single resource record from an RFC 1035 master file,
+// with its owner name fully qualified and its TTL and class resolved.
+type zoneFileRecord struct {
+	Name  string
+	TTL   int
+	Class string
+	Type  string
+	Rdata []string
+}
+
+// String returns the record as a single master file line.
+func (r *zoneFileRecord) String() string {
+	return fmt.Sprintf("%s %d %s %s %s", r.Name, r.TTL, r.Class, r.Type, strings.Join(r.Rdata, " "))
+}
+
+// zoneFileNameFields lists, per record type, the (zero based) rdata fields
+// holding domain names. These are qualified against the origin so relative
+// and absolute spellings of the same record compare equal.
+var zoneFileNameFields = map[string][]int{
+	"AFSDB": {1},
+	"CNAME": {0},
+	"DNAME": {0},
+	"MX":    {1},
+	"NAPTR": {5},
+	"NS":    {0},
+	"PTR":   {0},
+	"RP":    {0, 1},
+	"SOA":   {0, 1},
+	"SRV":   {3},
+}
+
+var zoneFileClasses = map[string]bool{
+	"IN": true,
+	"CH": true,
+	"HS": true,
+	"CS": true,
+}
+
+// zoneFileLine is a logical master file line. Parentheses let an entry span
+// several physical lines, and a leading blank means the owner is omitted.
+type zoneFileLine struct {
+	number       int
+	leadingBlank bool
+	tokens       []string
+}
+
+// splitZoneFileLines tokenizes master file text into logical lines,
+// dropping comments and keeping quoted character-strings intact.
+func splitZoneFileLines(text string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var cur zoneFileLine
+	var tok strings.Builder
+
+	number := 1
+	depth := 0
+	inQuote := false
+	inComment := false
+	startOfLine := true
+
+	flushToken := func() {
+		if tok.Len() > 0 {
+			cur.tokens = append(cur.tokens, tok.String())
+			tok.Reset()
+		}
+	}
+	flushLine := func() {
+		flushToken()
+		if len(cur.tokens) > 0 {
+			lines = append(lines, cur)
+		}
+		cur = zoneFileLine{}
+	}
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		if inComment {
+			if c != '\n' {
+				continue
+			}
+			inComment = false
+		}
+
+		if inQuote {
+			tok.WriteByte(c)
+			switch c {
+			case '\\':
+				if i+1 < len(text) {
+					i++
+					tok.WriteByte(text[i])
+				}
+			case '"':
+				inQuote = false
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+			}
+			continue
+		}
+
+		if startOfLine {
+			cur.number = number
+			cur.leadingBlank = c == ' ' || c == '\t'
+			startOfLine = false
+		}
+
+		switch c {
+		case ';':
+			inComment = true
+		case '"':
+			inQuote = true
+			tok.WriteByte(c)
+		case '\\':
+			tok.WriteByte(c)
+			if i+1 < len(text) {
+				i++
+				tok.WriteByte(text[i])
+			}
+		case '(':
+			flushToken()
+			depth++
+		case ')':
+			flushToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parenthesis", number)
+			}
+			depth--
+		case ' ', '\t', '\r':
+			flushToken()
+		case '\n':
+			number++
+			if depth == 0 {
+				flushLine()
+				startOfLine = true
+			} else {
+				flushToken()
+			}
+		default:
+			tok.WriteByte(c)
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parenthesis", number)
+	}
+	flushLine()
+
+	return lines, nil
+}
+
+// parseZoneFileTTL parses a TTL given either in seconds or in the BIND
+// unit notation such as 1h30m.
+func parseZoneFileTTL(s string) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, n >= 0
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, n, digits := 0, 0, 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= '0' && c <= '9':
+			n = n*10 + int(c-'0')
+			digits++
+		case digits > 0 && units[toLowerByte(c)] != 0:
+			total += n * units[toLowerByte(c)]
+			n, digits = 0, 0
+		default:
+			return 0, false
+		}
+	}
+	if digits > 0 || total == 0 {
+		return 0, false
+	}
+	return total, true
+}
+
+func toLowerByte(c byte) byte {
+	if c >= 'A' && c <= 'Z' {
+		return c + ('a' - 'A')
+	}
+	return c
+}
+
+// qualifyZoneFileName returns name as a lower case, fully qualified name
+// with a trailing dot, resolving "@" and relative names against origin.
+func qualifyZoneFileName(name, origin string) string {
+	origin = strings.ToLower(strings.TrimSuffix(origin, ".")) + "."
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(name)
+	default:
+		return strings.ToLower(name) + "." + origin
+	}
+}
+
+// parseZoneFile parses RFC 1035 master file text for the zone origin.
+// $ORIGIN and $TTL directives are honored, $INCLUDE is not supported.
+func parseZoneFile(text, origin string) ([]*zoneFileRecord, error) {
+	lines, err := splitZoneFileLines(text)
+	if err != nil {
+		return nil, err
+	}
+
+	origin = strings.ToLower(strings.TrimSuffix(origin, ".")) + "."
+	defaultTTL := -1
+	lastTTL := -1
+	var owner string
+	var records []*zoneFileRecord
+
+	for _, l := range lines {
+		tokens := l.tokens
+
+		if strings.HasPrefix(tokens[0], "$") && !l.leadingBlank {
+			switch strings.ToUpper(tokens[0]) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN takes exactly one name", l.number)
+				}
+				origin = qualifyZoneFileName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL takes exactly one value", l.number)
+				}
+				ttl, ok := parseZoneFileTTL(tokens[1])
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid $TTL %q", l.number, tokens[1])
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", l.number, tokens[0])
+			}
+			continue
+		}
+
+		if !l.leadingBlank {
+			owner = qualifyZoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", l.number)
+		}
+
+		rec := &zoneFileRecord{
+			Name:  owner,
+			TTL:   -1,
+			Class: "IN",
+		}
+
+		// The TTL and class are both optional and may come in either order.
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if ttl, ok := parseZoneFileTTL(tokens[0]); ok && rec.TTL < 0 {
+				rec.TTL = ttl
+				tokens = tokens[1:]
+			} else if zoneFileClasses[strings.ToUpper(tokens[0])] {
+				rec.Class = strings.ToUpper(tokens[0])
+				tokens = tokens[1:]
+			}
+		}
+
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record for %s is missing its type or rdata", l.number, owner)
+		}
+
+		rec.Type = strings.ToUpper(tokens[0])
+		rec.Rdata = append([]string{}, tokens[1:]...)
+
+		for _, f := range zoneFileNameFields[rec.Type] {
+			if f < len(rec.Rdata) && rec.Rdata[f] != "." {
+				rec.Rdata[f] = qualifyZoneFileName(rec.Rdata[f], origin)
+			}
+		}
+
+		switch {
+		case rec.TTL >= 0:
+			lastTTL = rec.TTL
+		case defaultTTL >= 0:
+			rec.TTL = defaultTTL
+		case lastTTL >= 0:
+			rec.TTL = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: record for %s has no TTL and no $TTL directive is set", l.number, owner)
+		}
+
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+// canonicalZoneFileRecords renders records one per line. Sorting orders
+// them by name, type and rdata, with TTL and class only breaking ties, and
+// stripping serials zeroes the SOA serial, which Akamai bumps on every
+// change.
+func canonicalZoneFileRecords(records []*zoneFileRecord, sortRecords, stripSerial bool) []string {
+	recs := make([]zoneFileRecord, 0, len(records))
+	for _, r := range records {
+		rec := *r
+		if stripSerial && rec.Type == "SOA" && len(rec.Rdata) > 2 {
+			rec.Rdata = append([]string{}, r.Rdata...)
+			rec.Rdata[2] = "0"
+		}
+		recs = append(recs, rec)
+	}
+
+	if sortRecords {
+		sort.SliceStable(recs, func(i, j int) bool {
+			a, b := recs[i], recs[j]
+			if a.Name != b.Name {
+				return a.Name < b.Name
+			}
+			if a.Type != b.Type {
+				return a.Type < b.Type
+			}
+			if ra, rb := strings.Join(a.Rdata, " "), strings.Join(b.Rdata, " "); ra != rb {
+				return ra < rb
+			}
+			if a.TTL != b.TTL {
+				return a.TTL < b.TTL
+			}
+			return a.Class < b.Class
+		})
+	}
+
+	lines := make([]string, 0, len(recs))
+	for i := range recs {
+		lines = append(lines, recs[i].String())
+	}
+	return lines
+}
+
+// zoneFilesEquivalent reports whether two master files hold the same
+// records, ignoring formatting, record order and the SOA serial.
+func zoneFilesEquivalent(a, b, origin string) bool {
+	ra, err := parseZoneFile(a, origin)
+	if err != nil {
+		return false
+	}
+	rb, err := parseZoneFile(b, origin)
+	if err != nil {
+		return false
+	}
+
+	la := canonicalZoneFileRecords(ra, true, true)
+	lb := canonicalZoneFileRecords(rb, true, true)
+	if len(la) != len(lb) {
+		return false
+	}
+	for i := range la {
+		if la[i] != lb[i] {
+			return false
+		}
+	}
+	return true
+}
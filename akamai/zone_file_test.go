@@ -0,0 +1,100 @@
+package akamai
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	text := `$ORIGIN porchetta.io.
+$TTL 1h
+@	IN	SOA	a1-49.akam.net. hostmaster ( 2019070101 ; serial
+			3600 600 604800 300 )
+	IN	NS	a1-49.akam.net.
+www	300	IN	A	127.0.0.1 ; web
+	IN	A	127.0.0.2
+mail	MX	10 mx1
+txt	TXT	"v=spf1 ; -all" "second"
+`
+
+	records, err := parseZoneFile(text, "porchetta.io")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := []string{
+		"porchetta.io. 3600 IN SOA a1-49.akam.net. hostmaster.porchetta.io. 2019070101 3600 600 604800 300",
+		"porchetta.io. 3600 IN NS a1-49.akam.net.",
+		"www.porchetta.io. 300 IN A 127.0.0.1",
+		"www.porchetta.io. 3600 IN A 127.0.0.2",
+		"mail.porchetta.io. 3600 IN MX 10 mx1.porchetta.io.",
+		`txt.porchetta.io. 3600 IN TXT "v=spf1 ; -all" "second"`,
+	}
+
+	actual := canonicalZoneFileRecords(records, false, false)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("got:\n%#v\nexpected:\n%#v", actual, expected)
+	}
+}
+
+func TestParseZoneFile_errors(t *testing.T) {
+	cases := []string{
+		"www A 127.0.0.1\n",
+		"www 300 IN A ( 127.0.0.1\n",
+		"www 300 IN TXT \"unterminated\n",
+		"$INCLUDE other.zone\n",
+		"www 300 IN A\n",
+	}
+
+	for _, tc := range cases {
+		if _, err := parseZoneFile(tc, "porchetta.io"); err == nil {
+			t.Fatalf("expected error parsing %q", tc)
+		}
+	}
+}
+
+func TestZoneFilesEquivalent(t *testing.T) {
+	a := `$TTL 300
+@ IN SOA a1-49.akam.net. hostmaster.porchetta.io. 1 3600 600 604800 300
+www IN CNAME web
+`
+	b := `www.porchetta.io.   300 IN CNAME web.porchetta.io.
+porchetta.io. 300 IN SOA a1-49.akam.net. hostmaster.porchetta.io. 2 3600 600 604800 300
+`
+	c := `www.porchetta.io. 300 IN CNAME other.porchetta.io.
+porchetta.io. 300 IN SOA a1-49.akam.net. hostmaster.porchetta.io. 2 3600 600 604800 300
+`
+
+	if !zoneFilesEquivalent(a, b, "porchetta.io") {
+		t.Fatalf("expected zone files to be equivalent")
+	}
+
+	if zoneFilesEquivalent(a, c, "porchetta.io") {
+		t.Fatalf("expected zone files to differ")
+	}
+}
+
+func TestCanonicalZoneFileRecords_sort(t *testing.T) {
+	records := []*zoneFileRecord{
+		{Name: "www.porchetta.io.", TTL: 60, Class: "IN", Type: "TXT", Rdata: []string{`"b"`}},
+		{Name: "www.porchetta.io.", TTL: 300, Class: "IN", Type: "A", Rdata: []string{"127.0.0.2"}},
+		{Name: "www.porchetta.io.", TTL: 3600, Class: "IN", Type: "A", Rdata: []string{"127.0.0.1"}},
+		{Name: "porchetta.io.", TTL: 300, Class: "IN", Type: "SOA", Rdata: []string{"a1-49.akam.net.", "hostmaster.porchetta.io.", "7", "3600", "600", "604800", "300"}},
+	}
+
+	expected := []string{
+		"porchetta.io. 300 IN SOA a1-49.akam.net. hostmaster.porchetta.io. 0 3600 600 604800 300",
+		"www.porchetta.io. 3600 IN A 127.0.0.1",
+		"www.porchetta.io. 300 IN A 127.0.0.2",
+		`www.porchetta.io. 60 IN TXT "b"`,
+	}
+
+	actual := canonicalZoneFileRecords(records, true, true)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("got:\n%#v\nexpected:\n%#v", actual, expected)
+	}
+
+	if records[3].Rdata[2] != "7" {
+		t.Fatalf("stripping the serial modified the input record")
+	}
+}
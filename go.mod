@@ -6,4 +6,5 @@ require (
 	github.com/hashicorp/terraform v0.12.0
 	github.com/stretchr/objx v0.2.0 // indirect
 	github.com/trussworks/akamai-sdk-go v0.0.0-20190701185604-23f10c0e1b75
+	golang.org/x/net v0.0.0-20190502183928-7f726cade0ab
 )